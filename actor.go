@@ -0,0 +1,169 @@
+package minesweeper
+
+import (
+	"errors"
+	"io"
+	"sync"
+)
+
+var (
+	// ErrActorStopped is returned when a request is sent to a GameActor that is already stopped.
+	ErrActorStopped = errors.New("game actor is stopped")
+)
+
+// OperateReply contains the result of Game.Operate called via GameActor.
+type OperateReply struct {
+	State GameState
+	Err   error
+}
+
+// HintReply contains the result of Game.Hint called via GameActor.
+type HintReply struct {
+	Coordinate *Coordinate
+	Err        error
+}
+
+// SaveReply contains the result of Game.Save called via GameActor.
+type SaveReply struct {
+	Written int
+	Err     error
+}
+
+// GameActor owns a Game on a dedicated goroutine and applies requests one by one in the order they are received.
+// Because only the owning goroutine touches the Game, callers on multiple goroutines can share a GameActor without locks.
+//
+// Each request method returns a channel that works as a reply future; the reply is sent exactly once and the channel is never closed.
+// Use NewGameActor to properly construct and start one, and call Stop when it is no longer used.
+type GameActor struct {
+	game     *Game
+	requests chan func()
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewGameActor constructs a GameActor and starts the goroutine that owns given Game.
+// The Game must not be accessed directly after this call.
+func NewGameActor(game *Game) *GameActor {
+	actor := &GameActor{
+		game:     game,
+		requests: make(chan func()),
+		stop:     make(chan struct{}),
+	}
+	go actor.run()
+	return actor
+}
+
+func (a *GameActor) run() {
+	for {
+		select {
+		case req := <-a.requests:
+			req()
+
+		case <-a.stop:
+			return
+
+		}
+	}
+}
+
+// send passes given request to the owning goroutine.
+// When the actor is already stopped, fallback is called instead.
+func (a *GameActor) send(req func(), fallback func()) {
+	// Check beforehand since select below picks one at random when both cases are ready
+	select {
+	case <-a.stop:
+		fallback()
+		return
+	default:
+	}
+
+	select {
+	case a.requests <- req:
+	case <-a.stop:
+		fallback()
+	}
+}
+
+// Operate sends given input to the owning goroutine to be applied via Game.Operate.
+func (a *GameActor) Operate(b []byte) <-chan *OperateReply {
+	return a.OperateAs(nil, b)
+}
+
+// OperateAs sends given input to the owning goroutine to be applied via Game.OperateAs with given Actor.
+func (a *GameActor) OperateAs(actor *Actor, b []byte) <-chan *OperateReply {
+	input := append([]byte(nil), b...)
+	reply := make(chan *OperateReply, 1)
+	a.send(func() {
+		state, err := a.game.OperateAs(actor, input)
+		reply <- &OperateReply{State: state, Err: err}
+	}, func() {
+		reply <- &OperateReply{Err: ErrActorStopped}
+	})
+	return reply
+}
+
+// Hint lets the owning goroutine ask for a safe cell via Game.Hint.
+func (a *GameActor) Hint() <-chan *HintReply {
+	return a.HintAs(nil)
+}
+
+// HintAs lets the owning goroutine ask for a safe cell via Game.HintAs with given Actor.
+func (a *GameActor) HintAs(actor *Actor) <-chan *HintReply {
+	reply := make(chan *HintReply, 1)
+	a.send(func() {
+		coord, err := a.game.HintAs(actor)
+		reply <- &HintReply{Coordinate: coord, Err: err}
+	}, func() {
+		reply <- &HintReply{Err: ErrActorStopped}
+	})
+	return reply
+}
+
+// Adjust sends given adjustments to the owning goroutine to be applied via Game.Adjust.
+func (a *GameActor) Adjust(adjustments ...Adjustment) <-chan error {
+	return a.AdjustAs(nil, adjustments...)
+}
+
+// AdjustAs sends given adjustments to the owning goroutine to be applied via Game.AdjustAs with given Actor.
+func (a *GameActor) AdjustAs(actor *Actor, adjustments ...Adjustment) <-chan error {
+	reply := make(chan error, 1)
+	a.send(func() {
+		reply <- a.game.AdjustAs(actor, adjustments...)
+	}, func() {
+		reply <- ErrActorStopped
+	})
+	return reply
+}
+
+// Render lets the owning goroutine write the current game via Game.Render.
+// Given io.Writer is written from the owning goroutine.
+func (a *GameActor) Render(w io.Writer) <-chan error {
+	reply := make(chan error, 1)
+	a.send(func() {
+		reply <- a.game.Render(w)
+	}, func() {
+		reply <- ErrActorStopped
+	})
+	return reply
+}
+
+// Save lets the owning goroutine serialize the current game via Game.Save.
+// Given io.Writer is written from the owning goroutine.
+func (a *GameActor) Save(w io.Writer) <-chan *SaveReply {
+	reply := make(chan *SaveReply, 1)
+	a.send(func() {
+		i, err := a.game.Save(w)
+		reply <- &SaveReply{Written: i, Err: err}
+	}, func() {
+		reply <- &SaveReply{Err: ErrActorStopped}
+	})
+	return reply
+}
+
+// Stop stops the owning goroutine.
+// A request that is already accepted is completed, while any request sent afterwards is replied with ErrActorStopped.
+func (a *GameActor) Stop() {
+	a.stopOnce.Do(func() {
+		close(a.stop)
+	})
+}
@@ -0,0 +1,183 @@
+package minesweeper
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+)
+
+func TestNewGameActor(t *testing.T) {
+	game := &Game{}
+	actor := NewGameActor(game)
+	defer actor.Stop()
+
+	if actor.game != game {
+		t.Error("Given game is not set.")
+	}
+}
+
+func TestGameActor_Operate(t *testing.T) {
+	flagged := 0
+	game := &Game{
+		ui: &DummyUI{
+			ParseInputFunc: func(_ []byte) (OpType, *Coordinate, error) {
+				// Not guarded by lock since only the owning goroutine calls this
+				flagged++
+				return Flag, &Coordinate{X: flagged - 1, Y: 0}, nil
+			},
+		},
+		field: &Field{
+			Width:  100,
+			Height: 1,
+			Cells:  [][]Cell{make([]Cell, 100)},
+		},
+		state: InProgress,
+	}
+	for i := range game.field.Cells[0] {
+		game.field.Cells[0][i] = &cell{state: Closed}
+	}
+	actor := NewGameActor(game)
+	defer actor.Stop()
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reply := <-actor.Operate([]byte("dummy"))
+			if reply.Err != nil {
+				t.Errorf("Unexpected error is returned: %s.", reply.Err.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, c := range game.field.Cells[0] {
+		if c.State() != Flagged {
+			t.Errorf("Cell #%d is not flagged.", i)
+		}
+	}
+}
+
+func TestGameActor_Render(t *testing.T) {
+	game := &Game{
+		ui: &DummyUI{
+			RenderFunc: func(w io.Writer, _ *Field) (int, error) {
+				return w.Write([]byte("dummy"))
+			},
+		},
+	}
+	actor := NewGameActor(game)
+	defer actor.Stop()
+
+	buf := bytes.NewBuffer([]byte{})
+	err := <-actor.Render(buf)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if buf.String() != "dummy" {
+		t.Errorf("Unexpected output is given: %s.", buf.String())
+	}
+}
+
+func TestGameActor_Save(t *testing.T) {
+	game := &Game{
+		field: &Field{},
+		state: InProgress,
+	}
+	actor := NewGameActor(game)
+	defer actor.Stop()
+
+	buf := bytes.NewBuffer([]byte{})
+	reply := <-actor.Save(buf)
+
+	if reply.Err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", reply.Err.Error())
+	}
+
+	if reply.Written == 0 || reply.Written != buf.Len() {
+		t.Errorf("Unexpected number of bytes is written: %d.", reply.Written)
+	}
+}
+
+func TestGameActor_As(t *testing.T) {
+	logger := &DummyAuditLogger{}
+	game := &Game{
+		ui: &DummyUI{
+			ParseInputFunc: func(_ []byte) (OpType, *Coordinate, error) {
+				return Open, &Coordinate{X: 3, Y: 0}, nil
+			},
+		},
+		field:       buildField("*...."),
+		state:       InProgress,
+		quota:       4,
+		hintBudget:  1,
+		auditLogger: logger,
+	}
+	actor := NewGameActor(game)
+	defer actor.Stop()
+	user := &Actor{ID: "user1", Source: "bot"}
+
+	operateReply := <-actor.OperateAs(user, []byte("dummy"))
+	if operateReply.Err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", operateReply.Err.Error())
+	}
+
+	// The game is already cleared, but the call is still recorded
+	hintReply := <-actor.HintAs(user)
+	if hintReply.Err != ErrOperatingFinishedGame {
+		t.Fatalf("Unexpected reply is returned: %#v.", hintReply)
+	}
+
+	err := <-actor.AdjustAs(user, AdjustLives(2))
+	if err != ErrOperatingFinishedGame {
+		t.Fatalf("Unexpected error is returned: %v.", err)
+	}
+
+	if len(logger.Entries) != 3 {
+		t.Fatalf("Unexpected number of entries are logged: %d.", len(logger.Entries))
+	}
+
+	for i, action := range []AuditAction{AuditOperate, AuditHint, AuditAdjust} {
+		entry := logger.Entries[i]
+		if entry.Actor != user || entry.Action != action {
+			t.Errorf("Unexpected entry is logged: %#v.", entry)
+		}
+	}
+}
+
+func TestGameActor_Stop(t *testing.T) {
+	actor := NewGameActor(&Game{})
+
+	actor.Stop()
+	// Multiple calls must not panic
+	actor.Stop()
+
+	reply := <-actor.Operate([]byte("dummy"))
+	if reply.Err != ErrActorStopped {
+		t.Errorf("Expected error is not returned: %v.", reply.Err)
+	}
+
+	err := <-actor.Render(bytes.NewBuffer([]byte{}))
+	if err != ErrActorStopped {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+
+	saveReply := <-actor.Save(bytes.NewBuffer([]byte{}))
+	if saveReply.Err != ErrActorStopped {
+		t.Errorf("Expected error is not returned: %v.", saveReply.Err)
+	}
+
+	hintReply := <-actor.Hint()
+	if hintReply.Err != ErrActorStopped {
+		t.Errorf("Expected error is not returned: %v.", hintReply.Err)
+	}
+
+	err = <-actor.Adjust(AdjustLives(2))
+	if err != ErrActorStopped {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
@@ -0,0 +1,174 @@
+package minesweeper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Adjustment represents a change that Game.Adjust applies to an in-progress game.
+// Only changes constructed by AdjustLives, AdjustAutoFlag and AdjustRules are allowed in the middle of a game.
+type Adjustment interface {
+	// String describes the change in a human readable form.
+	String() string
+
+	// validate checks if the change is legal for given game without modifying it.
+	validate(g *Game) error
+
+	// apply applies the change to given game. This must only be called after validate succeeds.
+	apply(g *Game)
+}
+
+type livesAdjustment int
+
+// AdjustLives creates Adjustment that sets the number of remaining lives.
+func AdjustLives(lives int) Adjustment {
+	return livesAdjustment(lives)
+}
+
+func (a livesAdjustment) String() string {
+	return fmt.Sprintf("lives=%d", int(a))
+}
+
+func (a livesAdjustment) validate(_ *Game) error {
+	if a <= 0 {
+		return fmt.Errorf("number of lives must be positive: %d", int(a))
+	}
+	return nil
+}
+
+func (a livesAdjustment) apply(g *Game) {
+	g.lives = int(a)
+}
+
+type autoFlagAdjustment bool
+
+// AdjustAutoFlag creates Adjustment that enables or disables automatic flagging introduced by WithAutoFlag.
+// The change takes effect on the next open.
+func AdjustAutoFlag(enabled bool) Adjustment {
+	return autoFlagAdjustment(enabled)
+}
+
+func (a autoFlagAdjustment) String() string {
+	return fmt.Sprintf("auto_flag=%t", bool(a))
+}
+
+func (autoFlagAdjustment) validate(_ *Game) error {
+	return nil
+}
+
+func (a autoFlagAdjustment) apply(g *Game) {
+	g.autoFlag = bool(a)
+}
+
+type rulesAdjustment struct {
+	rules Rules
+}
+
+// AdjustRules creates Adjustment that replaces Rules to judge the following operations.
+//
+// Some transitions are illegal because the current progress can not be carried over:
+// a sandbox game can not switch to other rules since mines are already revealed,
+// MoveBudgetRules can not be applied when the budget is already used up,
+// and no rules can be applied once every safe cell is opened since the game would only be judged again by another open.
+// Switching to SandboxRules turns the game into a sandbox in the same way as WithSandbox.
+func AdjustRules(rules Rules) Adjustment {
+	return &rulesAdjustment{rules: rules}
+}
+
+func (a *rulesAdjustment) String() string {
+	return fmt.Sprintf("rules=%T", a.rules)
+}
+
+func (a *rulesAdjustment) validate(g *Game) error {
+	if err := validateRules(a.rules); err != nil {
+		return err
+	}
+
+	// Rules only judge the game on the next operation, but there is no safe cell left to open
+	if g.field != nil && g.field.closedSafeCnt() == 0 {
+		return errors.New("every safe cell is already opened")
+	}
+
+	switch rules := a.rules.(type) {
+	case *SandboxRules:
+		return nil
+
+	case *MoveBudgetRules:
+		if g.opens >= rules.Budget {
+			return fmt.Errorf("move budget is already used up: %d", g.opens)
+		}
+
+	}
+
+	if g.sandbox {
+		return errors.New("sandbox game can not switch to other rules")
+	}
+
+	return nil
+}
+
+func (a *rulesAdjustment) apply(g *Game) {
+	g.rules = a.rules
+	if _, ok := a.rules.(*SandboxRules); ok {
+		g.sandbox = true
+		g.configureUI()
+	}
+}
+
+// Adjust changes settings of an in-progress game so a host can adjust them without restarting.
+// Every adjustment is validated against the current game first; when any of them is illegal, an error is returned and none is applied.
+// ErrOperatingFinishedGame is returned for a finished game.
+func (g *Game) Adjust(adjustments ...Adjustment) error {
+	return g.AdjustAs(nil, adjustments...)
+}
+
+// AdjustAs works in the same way as Adjust, and records the call with given Actor to AuditLogger given via WithAuditLogger.
+func (g *Game) AdjustAs(actor *Actor, adjustments ...Adjustment) error {
+	err := g.adjust(adjustments)
+
+	descriptions := make([]string, len(adjustments))
+	for i, adjustment := range adjustments {
+		if adjustment == nil {
+			descriptions[i] = "nil"
+			continue
+		}
+		descriptions[i] = adjustment.String()
+	}
+	g.audit(actor, AuditAdjust, nil, strings.Join(descriptions, ", "), err)
+
+	return err
+}
+
+func (g *Game) adjust(adjustments []Adjustment) error {
+	if g.state != InProgress {
+		return ErrOperatingFinishedGame
+	}
+
+	g.touch()
+
+	rulesCnt := 0
+	for _, adjustment := range adjustments {
+		if adjustment == nil {
+			return errors.New("adjustment must not be nil")
+		}
+
+		// Each rules adjustment is validated against the current rules, so only one is allowed at a time
+		if _, ok := adjustment.(*rulesAdjustment); ok {
+			rulesCnt++
+			if rulesCnt > 1 {
+				return errors.New("rules can only be adjusted once at a time")
+			}
+		}
+
+		err := adjustment.validate(g)
+		if err != nil {
+			return fmt.Errorf("failed to apply Adjustment of %s: %s", adjustment.String(), err.Error())
+		}
+	}
+
+	for _, adjustment := range adjustments {
+		adjustment.apply(g)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package minesweeper
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAdjustLives(t *testing.T) {
+	tests := []struct {
+		lives    int
+		hasError bool
+	}{
+		{
+			lives: 2,
+		},
+		{
+			lives:    0,
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{state: InProgress}
+			err := game.Adjust(AdjustLives(test.lives))
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.lives != test.lives {
+				t.Errorf("Unexpected number of lives is set: %d.", game.lives)
+			}
+		})
+	}
+}
+
+func TestAdjustAutoFlag(t *testing.T) {
+	game := &Game{state: InProgress, autoFlag: true}
+	err := game.Adjust(AdjustAutoFlag(false))
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if game.autoFlag {
+		t.Error("Auto flag is not disabled.")
+	}
+}
+
+func TestAdjustRules(t *testing.T) {
+	exploded := buildField("*..")
+	exploded.Cells[0][0].(*cell).state = Exploded
+
+	cleared := buildField("*..")
+	cleared.Cells[0][1].(*cell).state = Opened
+	cleared.Cells[0][2].(*cell).state = Opened
+
+	tests := []struct {
+		game     *Game
+		rules    Rules
+		hasError bool
+	}{
+		{
+			game:  &Game{field: buildField("*..")},
+			rules: &FlagAllMinesRules{},
+		},
+		{
+			game:     &Game{field: buildField("*..")},
+			rules:    nil,
+			hasError: true,
+		},
+		{
+			// Mines are already revealed
+			game:     &Game{field: buildField("*.."), sandbox: true, rules: &SandboxRules{}},
+			rules:    &StandardRules{},
+			hasError: true,
+		},
+		{
+			// An exploded mine is taken as found
+			game:  &Game{field: exploded, lives: 2},
+			rules: &FlagAllMinesRules{},
+		},
+		{
+			game:  &Game{field: exploded, lives: 2},
+			rules: &StandardRules{},
+		},
+		{
+			game:     &Game{field: buildField("*.."), opens: 2},
+			rules:    &MoveBudgetRules{Budget: 2},
+			hasError: true,
+		},
+		{
+			game:  &Game{field: buildField("*.."), opens: 1},
+			rules: &MoveBudgetRules{Budget: 2},
+		},
+		{
+			// Every safe cell is already opened
+			game:     &Game{field: cleared, rules: &FlagAllMinesRules{}},
+			rules:    &StandardRules{},
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := test.game
+			game.state = InProgress
+			original := game.rules
+			err := game.Adjust(AdjustRules(test.rules))
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				if game.rules != original {
+					t.Errorf("Rules must not be changed: %#v.", game.rules)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.rules != test.rules {
+				t.Errorf("Given rules are not set: %#v.", game.rules)
+			}
+		})
+	}
+}
+
+func TestAdjustRules_FlagAllMinesToStandard(t *testing.T) {
+	game := &Game{
+		field: buildField("*.."),
+		state: InProgress,
+		quota: 2,
+		rules: &FlagAllMinesRules{},
+	}
+
+	_, _ = game.operate(Open, &Coordinate{X: 2, Y: 0})
+	if game.state != InProgress {
+		t.Fatalf("Game should wait for flags: %s.", game.state)
+	}
+
+	err := game.Adjust(AdjustRules(&StandardRules{}))
+	if err == nil {
+		t.Fatal("Expected error is not returned.")
+	}
+
+	state, _ := game.operate(Flag, &Coordinate{X: 0, Y: 0})
+	if state != Cleared {
+		t.Errorf("Game should be cleared by the original rules: %s.", state)
+	}
+}
+
+func TestAdjustRules_Sandbox(t *testing.T) {
+	ui := &defaultUI{}
+	game := &Game{field: buildField("*.."), state: InProgress, ui: ui}
+
+	err := game.Adjust(AdjustRules(&SandboxRules{}))
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !game.sandbox {
+		t.Error("Game is not turned into a sandbox.")
+	}
+
+	if !ui.revealMines {
+		t.Error("Mines are not revealed.")
+	}
+}
+
+func TestAdjustment_String(t *testing.T) {
+	tests := []struct {
+		adjustment Adjustment
+		expected   string
+	}{
+		{
+			adjustment: AdjustLives(3),
+			expected:   "lives=3",
+		},
+		{
+			adjustment: AdjustAutoFlag(true),
+			expected:   "auto_flag=true",
+		},
+		{
+			adjustment: AdjustRules(&StandardRules{}),
+			expected:   "rules=*minesweeper.StandardRules",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			if test.adjustment.String() != test.expected {
+				t.Errorf("Unexpected description is returned: %s.", test.adjustment.String())
+			}
+		})
+	}
+}
+
+func TestGame_Adjust(t *testing.T) {
+	t.Run("applied", func(t *testing.T) {
+		game := &Game{state: InProgress, lives: 1}
+
+		err := game.Adjust(AdjustLives(3), AdjustAutoFlag(true))
+
+		if err != nil {
+			t.Fatalf("Unexpected error is returned: %s.", err.Error())
+		}
+
+		if game.lives != 3 || !game.autoFlag {
+			t.Errorf("Adjustments are not applied: %#v.", game)
+		}
+	})
+
+	t.Run("illegal adjustment", func(t *testing.T) {
+		game := &Game{state: InProgress, lives: 1}
+
+		err := game.Adjust(AdjustAutoFlag(true), AdjustLives(0))
+
+		if err == nil {
+			t.Fatal("Expected error is not returned.")
+		}
+
+		if game.lives != 1 || game.autoFlag {
+			t.Errorf("No adjustment should be applied: %#v.", game)
+		}
+	})
+
+	t.Run("multiple rules", func(t *testing.T) {
+		game := &Game{state: InProgress, field: buildField("*..")}
+
+		err := game.Adjust(AdjustRules(&SandboxRules{}), AdjustRules(&StandardRules{}))
+
+		if err == nil {
+			t.Fatal("Expected error is not returned.")
+		}
+
+		if game.rules != nil || game.sandbox {
+			t.Errorf("No adjustment should be applied: %#v.", game)
+		}
+	})
+
+	t.Run("nil adjustment", func(t *testing.T) {
+		game := &Game{state: InProgress}
+
+		err := game.Adjust(nil)
+
+		if err == nil {
+			t.Fatal("Expected error is not returned.")
+		}
+	})
+
+	t.Run("finished game", func(t *testing.T) {
+		game := &Game{state: Lost}
+
+		err := game.Adjust(AdjustLives(3))
+
+		if err != ErrOperatingFinishedGame {
+			t.Errorf("Expected error is not returned: %v.", err)
+		}
+	})
+}
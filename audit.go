@@ -0,0 +1,99 @@
+package minesweeper
+
+import (
+	"fmt"
+	"time"
+)
+
+// Actor identifies who applies a call to Game.
+type Actor struct {
+	// ID is an identifier of a user or a bot that is meaningful to the hosting application.
+	ID string
+
+	// Source is where the call comes from such as "cli", "http" or "bot".
+	Source string
+}
+
+// AuditAction represents a kind of state-changing call recorded to AuditLogger.
+type AuditAction int
+
+const (
+	_ AuditAction = iota
+
+	// AuditOperate represents a call to Game.Operate or Game.OperateAs.
+	AuditOperate
+
+	// AuditAdjust represents a call to Game.Adjust or Game.AdjustAs.
+	AuditAdjust
+
+	// AuditHint represents a call to Game.Hint or Game.HintAs.
+	AuditHint
+)
+
+// String returns stringified representation of AuditAction.
+func (a AuditAction) String() string {
+	switch a {
+	case AuditOperate:
+		return "Operate"
+
+	case AuditAdjust:
+		return "Adjust"
+
+	case AuditHint:
+		return "Hint"
+
+	default:
+		panic(fmt.Sprintf("unknown audit action is given: %d", a))
+
+	}
+}
+
+// AuditEntry is a record of a state-changing call to Game.
+type AuditEntry struct {
+	// Actor is who applied the call; nil when the call is made without one such as Game.Operate.
+	Actor *Actor
+
+	Action AuditAction
+	Time   time.Time
+
+	// Input is the raw input given to Game.Operate; nil for other actions.
+	Input []byte
+
+	// Detail describes the call when Input does not tell it:
+	// an operation applied by Env such as "Open 3:0", or the adjustments applied by Game.Adjust such as "lives=3, auto_flag=true".
+	Detail string
+
+	// Err is the error returned by the call; nil when the call succeeded.
+	Err error
+}
+
+// AuditLogger receives AuditEntry on every state-changing call to Game, including failed ones.
+// Log is called synchronously on the goroutine that calls Game, so an implementation should return quickly.
+type AuditLogger interface {
+	Log(entry *AuditEntry)
+}
+
+// WithAuditLogger creates GameOption that records every state-changing call to given AuditLogger.
+// Use Game.OperateAs, Game.AdjustAs and Game.HintAs to tell who applies the call.
+// Operations applied by Env are recorded with the Actor given via WithActor.
+func WithAuditLogger(logger AuditLogger) GameOption {
+	return func(g *Game) error {
+		g.auditLogger = logger
+		return nil
+	}
+}
+
+func (g *Game) audit(actor *Actor, action AuditAction, input []byte, detail string, err error) {
+	if g.auditLogger == nil {
+		return
+	}
+
+	g.auditLogger.Log(&AuditEntry{
+		Actor:  actor,
+		Action: action,
+		Time:   g.now(),
+		Input:  append([]byte(nil), input...),
+		Detail: detail,
+		Err:    err,
+	})
+}
@@ -0,0 +1,115 @@
+package minesweeper
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+type DummyAuditLogger struct {
+	Entries []*AuditEntry
+}
+
+func (l *DummyAuditLogger) Log(entry *AuditEntry) {
+	l.Entries = append(l.Entries, entry)
+}
+
+func TestAuditAction_String(t *testing.T) {
+	tests := []struct {
+		action AuditAction
+		str    string
+	}{
+		{
+			action: AuditOperate,
+			str:    "Operate",
+		},
+		{
+			action: AuditAdjust,
+			str:    "Adjust",
+		},
+		{
+			action: AuditHint,
+			str:    "Hint",
+		},
+		{
+			action: 123,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			defer func() {
+				r := recover()
+				if r != nil && test.str != "" {
+					t.Fatalf("Unexpectedly panicked for action: %d", test.action)
+				}
+			}()
+
+			str := test.action.String()
+
+			if str != test.str {
+				t.Errorf("Unexpected string is returned: %s.", str)
+			}
+		})
+	}
+}
+
+func TestWithAuditLogger(t *testing.T) {
+	game := &Game{}
+	logger := &DummyAuditLogger{}
+	err := WithAuditLogger(logger)(game)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if game.auditLogger != logger {
+		t.Errorf("Given logger is not set: %#v.", game.auditLogger)
+	}
+}
+
+func TestGame_audit(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	logger := &DummyAuditLogger{}
+	game := &Game{
+		field: buildField("*..."),
+		state: InProgress,
+		quota: 3,
+		ui: &DummyUI{
+			ParseInputFunc: func(_ []byte) (OpType, *Coordinate, error) {
+				return 0, nil, errors.New("dummy")
+			},
+		},
+		clock:       func() time.Time { return now },
+		auditLogger: logger,
+	}
+	actor := &Actor{ID: "user1", Source: "cli"}
+
+	_, opErr := game.OperateAs(actor, []byte("invalid"))
+	_, hintErr := game.Hint()
+	_ = game.AdjustAs(actor, AdjustLives(2))
+
+	if len(logger.Entries) != 3 {
+		t.Fatalf("Unexpected number of entries are logged: %d.", len(logger.Entries))
+	}
+
+	operated := logger.Entries[0]
+	if operated.Actor != actor || operated.Action != AuditOperate || string(operated.Input) != "invalid" || operated.Err != opErr {
+		t.Errorf("Unexpected entry is logged: %#v.", operated)
+	}
+
+	if !operated.Time.Equal(now) {
+		t.Errorf("Unexpected time is logged: %s.", operated.Time)
+	}
+
+	hinted := logger.Entries[1]
+	if hinted.Actor != nil || hinted.Action != AuditHint || hinted.Err != hintErr {
+		t.Errorf("Unexpected entry is logged: %#v.", hinted)
+	}
+
+	adjusted := logger.Entries[2]
+	if adjusted.Action != AuditAdjust || adjusted.Err != nil || adjusted.Detail != "lives=2" {
+		t.Errorf("Unexpected entry is logged: %#v.", adjusted)
+	}
+}
@@ -0,0 +1,208 @@
+// Command ms-solve reads a board from stdin and prints what the solver finds about it.
+//
+// A board is given in one of the following forms:
+//
+//   - JSON written by json.Marshal of minesweeper.Field, which carries the state of each cell.
+//   - A board code given by Field.Code, which represents a field with all cells closed.
+//   - An ASCII board with one line per row and one character per cell:
+//     "." for a closed safe cell, "*" for a closed mine, "-" or a surrounding count for an opened cell,
+//     "F" for a flagged mine, "f" for a wrongly flagged safe cell and "X" for an exploded mine.
+//
+// The guess count is computed from the given position, so cells that are already opened or exploded are taken into account.
+// Flags are not trusted in the same way as the deduction and the probabilities, since a flag may be placed by a lucky guess.
+// Coordinates are printed as x:y from the top-left corner starting at 0.
+//
+//	echo "3x3-hAA" | ms-solve
+//	printf '*1.\n11.\n...\n' | ms-solve
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/oklahomer/go-minesweeper"
+	"io"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var boardCodePattern = regexp.MustCompile(`^\d+x\d+-`)
+
+func main() {
+	err := run(os.Stdin, os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ms-solve: %s\n", err.Error())
+		os.Exit(1)
+	}
+}
+
+func run(r io.Reader, w io.Writer) error {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("failed to read input: %s", err.Error())
+	}
+
+	field, err := parseField(strings.TrimSpace(string(b)))
+	if err != nil {
+		return err
+	}
+
+	deduction := minesweeper.Deduce(field)
+	guesses := minesweeper.RemainingGuessCount(field)
+
+	str := fmt.Sprintf("safe: %s\n", coordinates(deduction.Safe))
+	str += fmt.Sprintf("mines: %s\n", coordinates(deduction.Mines))
+	str += fmt.Sprintf("guesses: %d\n", guesses)
+	str += fmt.Sprintf("solvable without guessing: %t\n", guesses == 0)
+	str += "probabilities:\n"
+	for _, row := range minesweeper.MineProbabilities(field) {
+		cells := make([]string, len(row))
+		for i, p := range row {
+			cells[i] = fmt.Sprintf("%.2f", p)
+		}
+		str += strings.Join(cells, " ") + "\n"
+	}
+
+	_, err = w.Write([]byte(str))
+	return err
+}
+
+func parseField(input string) (*minesweeper.Field, error) {
+	var field *minesweeper.Field
+	var err error
+	switch {
+	case strings.HasPrefix(input, "{"):
+		field = &minesweeper.Field{}
+		err = json.Unmarshal([]byte(input), field)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JSON: %s", err.Error())
+		}
+
+	case boardCodePattern.MatchString(input):
+		field, err = minesweeper.NewFieldFromCode(input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse board code: %s", err.Error())
+		}
+
+	default:
+		field, err = parseASCII(input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ASCII board: %s", err.Error())
+		}
+
+	}
+
+	mineCnt := 0
+	for _, cnt := range field.RowMineCnts() {
+		mineCnt += cnt
+	}
+	if mineCnt == field.Width*field.Height {
+		return nil, errors.New("board has no safe cell")
+	}
+
+	return field, nil
+}
+
+// parseASCII converts an ASCII board to a Field.
+// Surrounding counts are computed from the placement of mines, and a count given for an opened cell must match it.
+func parseASCII(input string) (*minesweeper.Field, error) {
+	lines := strings.Split(input, "\n")
+	width := len(strings.TrimSpace(lines[0]))
+	grid := make([][]byte, len(lines))
+	for y, line := range lines {
+		line = strings.TrimSpace(line)
+		if len(line) == 0 || len(line) != width {
+			return nil, fmt.Errorf("row %d must have %d cells: %q", y, width, line)
+		}
+		grid[y] = []byte(line)
+	}
+
+	hasMine := func(x int, y int) bool {
+		if y < 0 || y >= len(grid) || x < 0 || x >= width {
+			return false
+		}
+		switch grid[y][x] {
+		case '*', 'F', 'X':
+			return true
+
+		default:
+			return false
+
+		}
+	}
+
+	cells := make([][]map[string]interface{}, len(grid))
+	for y, row := range grid {
+		for x, symbol := range row {
+			cnt := 0
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					if (dx != 0 || dy != 0) && hasMine(x+dx, y+dy) {
+						cnt++
+					}
+				}
+			}
+
+			state := ""
+			switch {
+			case symbol == '.', symbol == '*':
+				state = "Closed"
+
+			case symbol == '-':
+				state = "Opened"
+
+			case symbol >= '0' && symbol <= '8':
+				if int(symbol-'0') != cnt {
+					return nil, fmt.Errorf("surrounding count at %d:%d must be %d: %c", x, y, cnt, symbol)
+				}
+				state = "Opened"
+
+			case symbol == 'F', symbol == 'f':
+				state = "Flagged"
+
+			case symbol == 'X':
+				state = "Exploded"
+
+			default:
+				return nil, fmt.Errorf("unknown symbol at %d:%d: %c", x, y, symbol)
+
+			}
+
+			cells[y] = append(cells[y], map[string]interface{}{
+				"state":             state,
+				"has_mine":          hasMine(x, y),
+				"surrounding_count": cnt,
+			})
+		}
+	}
+
+	b, err := json.Marshal(map[string]interface{}{
+		"width":  width,
+		"height": len(grid),
+		"cells":  cells,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	field := &minesweeper.Field{}
+	err = json.Unmarshal(b, field)
+	if err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+func coordinates(coords []*minesweeper.Coordinate) string {
+	if len(coords) == 0 {
+		return "-"
+	}
+
+	strs := make([]string, len(coords))
+	for i, c := range coords {
+		strs[i] = fmt.Sprintf("%d:%d", c.X, c.Y)
+	}
+	return strings.Join(strs, " ")
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func Test_run(t *testing.T) {
+	tests := []struct {
+		input    string
+		contains []string
+		hasError bool
+	}{
+		{
+			input:    "3x3-hAA\n",
+			contains: []string{"safe: -", "guesses: 1", "solvable without guessing: false"},
+		},
+		{
+			input:    `{"cells":[[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":true,"state":"Closed","surrounding_count":0}]],"height":1,"width":2}`,
+			contains: []string{"safe: -", "mines: 1:0", "0.00 1.00"},
+		},
+		{
+			// Already solved
+			input:    `{"cells":[[{"has_mine":true,"state":"Flagged","surrounding_count":0},{"has_mine":false,"state":"Opened","surrounding_count":1}],[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":false,"state":"Opened","surrounding_count":1}]],"height":2,"width":2}`,
+			contains: []string{"guesses: 0", "solvable without guessing: true"},
+		},
+		{
+			// Starting from scratch is free of guesses, but the given position is a 50:50
+			input:    "*1.\n",
+			contains: []string{"safe: -", "guesses: 1", "solvable without guessing: false"},
+		},
+		{
+			// Flags are not trusted
+			input:    "F1.",
+			contains: []string{"guesses: 1", "solvable without guessing: false", "0.50 0.00 0.50"},
+		},
+		{
+			// Nothing is opened yet
+			input:    "*..\n...",
+			contains: []string{"guesses: 1", "0.17 0.17 0.17\n0.17 0.17 0.17"},
+		},
+		{
+			// Wrong surrounding count
+			input:    "*2.",
+			hasError: true,
+		},
+		{
+			// Rows of different lengths
+			input:    "*..\n..",
+			hasError: true,
+		},
+		{
+			input:    "invalid",
+			hasError: true,
+		},
+		{
+			// No safe cell
+			input:    "1x1-gA",
+			hasError: true,
+		},
+		{
+			input:    "**",
+			hasError: true,
+		},
+		{
+			input:    `{"cells":[],"height":1,"width":1}`,
+			hasError: true,
+		},
+		{
+			// Safe start has a mine
+			input:    `{"cells":[[{"has_mine":false,"state":"Closed","surrounding_count":1},{"has_mine":true,"state":"Closed","surrounding_count":0}]],"height":1,"width":2,"safe_start":{"x":1,"y":0}}`,
+			hasError: true,
+		},
+		{
+			input:    `{"cells":[[{"has_mine":false,"state":"Closed","surrounding_count":1},{"has_mine":true,"state":"Closed","surrounding_count":0}]],"height":1,"width":2,"safe_start":{"x":5,"y":0}}`,
+			hasError: true,
+		},
+		{
+			input:    `{"cells":[[{"has_mine":true,"state":"Closed","surrounding_count":0}]],"height":1,"width":2}`,
+			hasError: true,
+		},
+		{
+			input:    "{invalid",
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			w := bytes.NewBuffer([]byte{})
+			err := run(strings.NewReader(test.input), w)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			for _, str := range test.contains {
+				if !strings.Contains(w.String(), str) {
+					t.Errorf("Output does not contain %q: \n%s", str, w.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package minesweeper
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrFieldSizeMismatch is returned when fields of different sizes are compared.
+	ErrFieldSizeMismatch = errors.New("fields have different sizes")
+)
+
+// DiffFields returns coordinates of cells that differ between given fields in state, mine placement or surrounding count.
+// This is handy to debug Restore and field generation.
+func DiffFields(a *Field, b *Field) ([]*Coordinate, error) {
+	if a.Width != b.Width || a.Height != b.Height {
+		return nil, ErrFieldSizeMismatch
+	}
+
+	var diff []*Coordinate
+	for y, row := range a.Cells {
+		for x, c := range row {
+			other := b.Cells[y][x]
+			if c.State() != other.State() || c.hasMine() != other.hasMine() || c.SurroundingCnt() != other.SurroundingCnt() {
+				diff = append(diff, &Coordinate{X: x, Y: y})
+			}
+		}
+	}
+	return diff, nil
+}
+
+// WriteDiff writes given fields side by side to given io.Writer and marks each differing cell with "!" on both sides.
+// Unlike UI, underlying mines are revealed so every difference DiffFields finds is visible:
+// a closed cell with a mine is "*", an opened cell is its surrounding count or "-" for zero, a flagged cell is "F" and an exploded cell is "X".
+// Each cell is as wide as the widest x-coordinate label plus one column for the mark, so labels stay aligned on wide fields.
+func WriteDiff(w io.Writer, a *Field, b *Field) (int, error) {
+	diff, err := DiffFields(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	differs := map[Coordinate]bool{}
+	for _, c := range diff {
+		differs[*c] = true
+	}
+
+	symbols := &defaultUI{}
+	symbols.initSymbols(a.Width, a.Height)
+	yWidth := len(symbols.ySymbols[len(symbols.ySymbols)-1])
+	cellWidth := len(symbols.xSymbols[len(symbols.xSymbols)-1]) + 1
+
+	header := strings.Repeat(" ", yWidth)
+	for _, symbol := range symbols.xSymbols {
+		header += fmt.Sprintf(" %-*s", cellWidth, symbol)
+	}
+	str := header + "   " + header
+
+	for y := range a.Cells {
+		str += "\n" + diffRow(symbols.ySymbols[y], yWidth, cellWidth, y, a.Cells[y], differs) +
+			"   " + diffRow(symbols.ySymbols[y], yWidth, cellWidth, y, b.Cells[y], differs)
+	}
+
+	return w.Write([]byte(str))
+}
+
+func diffRow(ySymbol string, yWidth int, cellWidth int, y int, row []Cell, differs map[Coordinate]bool) string {
+	str := fmt.Sprintf("%-*s", yWidth, ySymbol)
+	for x, c := range row {
+		mark := " "
+		if differs[Coordinate{X: x, Y: y}] {
+			mark = "!"
+		}
+		str += fmt.Sprintf("|%-*s%s", cellWidth-1, dispRevealed(c), mark)
+	}
+	return str
+}
+
+func dispRevealed(c Cell) string {
+	switch c.State() {
+	case Closed:
+		if c.hasMine() {
+			return "*"
+		}
+		return " "
+
+	case Opened:
+		cnt := c.SurroundingCnt()
+		if cnt == 0 {
+			return "-"
+		}
+		return strconv.Itoa(cnt)
+
+	case Flagged:
+		return "F"
+
+	case Exploded:
+		return "X"
+
+	default:
+		panic("invalid state")
+
+	}
+}
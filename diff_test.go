@@ -0,0 +1,90 @@
+package minesweeper
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDiffFields(t *testing.T) {
+	a := buildField(
+		"*..",
+		"...",
+	)
+	b := buildField(
+		"*..",
+		"...",
+	)
+	b.Cells[0][2].(*cell).state = Opened
+	b.Cells[1][0].(*cell).state = Flagged
+
+	diff, err := DiffFields(a, b)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if coordsString(diff) != "2:0 0:1 " {
+		t.Errorf("Unexpected diff is returned: %s.", coordsString(diff))
+	}
+
+	_, err = DiffFields(a, buildField("*.."))
+	if err != ErrFieldSizeMismatch {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	a := buildField(
+		"*..",
+		"...",
+	)
+	b := buildField(
+		"*..",
+		"...",
+	)
+	b.Cells[0][2].(*cell).state = Opened
+	b.Cells[1][0].(*cell).state = Flagged
+
+	w := bytes.NewBuffer([]byte{})
+	_, err := WriteDiff(w, a, b)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	expected := "  1  2  3      1  2  3 \na|* |  | !   a|* |  |-!\nb| !|  |     b|F!|  |  "
+	if w.String() != expected {
+		t.Errorf("Unexpected output: \n%s", w.String())
+	}
+
+	_, err = WriteDiff(w, a, buildField("*.."))
+	if err != ErrFieldSizeMismatch {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
+
+func TestWriteDiff_WideLabels(t *testing.T) {
+	a := buildField("*..........")
+	b := buildField("*..........")
+	b.Cells[0][10].(*cell).state = Opened
+
+	w := bytes.NewBuffer([]byte{})
+	_, err := WriteDiff(w, a, b)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	lines := strings.Split(w.String(), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Unexpected number of lines: \n%s", w.String())
+	}
+
+	// The label of the last column must be right above its cell
+	label := strings.LastIndex(lines[0], "11")
+	cell := strings.LastIndex(lines[1], "- !")
+	if label != cell {
+		t.Errorf("Header is misaligned: \n%s", w.String())
+	}
+}
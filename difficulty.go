@@ -0,0 +1,213 @@
+package minesweeper
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Difficulty depicts how hard a field is to clear.
+type Difficulty int
+
+const (
+	_ Difficulty = iota
+
+	// Beginner represents a small and sparse field such as the classic 9x9 field with 10 mines.
+	Beginner
+
+	// Intermediate represents a medium field such as the classic 16x16 field with 40 mines.
+	Intermediate
+
+	// Expert represents a large or dense field such as the classic 30x16 field with 99 mines.
+	Expert
+
+	// Evil represents a field that is harder than the classic expert field.
+	Evil
+)
+
+// String returns stringified representation of Difficulty.
+func (d Difficulty) String() string {
+	switch d {
+	case Beginner:
+		return "Beginner"
+
+	case Intermediate:
+		return "Intermediate"
+
+	case Expert:
+		return "Expert"
+
+	case Evil:
+		return "Evil"
+
+	default:
+		panic(fmt.Sprintf("unknown difficulty is given: %d", d))
+
+	}
+}
+
+// MarshalJSON returns Difficulty value that can be part of JSON structure.
+func (d Difficulty) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%s"`, d.String())), nil
+}
+
+// UnmarshalJSON converts given JSON string such as "Beginner" to Difficulty.
+func (d *Difficulty) UnmarshalJSON(b []byte) error {
+	var str string
+	err := json.Unmarshal(b, &str)
+	if err != nil {
+		return err
+	}
+
+	difficulty, err := strToDifficulty(str)
+	if err != nil {
+		return err
+	}
+
+	*d = difficulty
+	return nil
+}
+
+func strToDifficulty(str string) (Difficulty, error) {
+	switch str {
+	case "Beginner":
+		return Beginner, nil
+
+	case "Intermediate":
+		return Intermediate, nil
+
+	case "Expert":
+		return Expert, nil
+
+	case "Evil":
+		return Evil, nil
+
+	default:
+		return 0, fmt.Errorf("unknown difficulty is given: %s", str)
+
+	}
+}
+
+// raiseThreshold defines values that make a field harder than usual fields of the same base level.
+// These are roughly the 90th percentile of randomly generated classic fields of each level.
+type raiseThreshold struct {
+	threeBVRatio float64
+	guesses      int
+}
+
+var raiseThresholds = map[Difficulty]*raiseThreshold{
+	Beginner: {
+		threeBVRatio: 0.35,
+		guesses:      2,
+	},
+	Intermediate: {
+		threeBVRatio: 0.42,
+		guesses:      3,
+	},
+	Expert: {
+		threeBVRatio: 0.55,
+		guesses:      7,
+	},
+}
+
+// ClassifyDifficulty returns Difficulty of given Field judging from its size, mine density, 3BV and the number of forced guesses.
+//
+// The base level is decided by the number of cells and mine density so the classic beginner, intermediate and expert fields fall into their own levels.
+// A field with a mine density of 25% or higher is Evil regardless of its size.
+// Then the level is raised by one when 3BV relative to the number of safe cells is higher than usual for the base level,
+// and by one more when GuessCount is higher than usual.
+func ClassifyDifficulty(field *Field) Difficulty {
+	cells := field.Width * field.Height
+	mines := 0
+	for _, row := range field.Cells {
+		for _, c := range row {
+			if c.hasMine() {
+				mines++
+			}
+		}
+	}
+	density := float64(mines) / float64(cells)
+
+	var difficulty Difficulty
+	switch {
+	case density >= 0.25:
+		return Evil
+
+	case cells <= 9*9 && density < 0.16:
+		difficulty = Beginner
+
+	case cells <= 16*16 && density < 0.19:
+		difficulty = Intermediate
+
+	default:
+		difficulty = Expert
+
+	}
+
+	threshold := raiseThresholds[difficulty]
+	raised := difficulty
+
+	if safe := cells - mines; safe > 0 && float64(ThreeBV(field))/float64(safe) >= threshold.threeBVRatio {
+		raised++
+	}
+
+	if GuessCount(field) >= threshold.guesses {
+		raised++
+	}
+
+	if raised > Evil {
+		return Evil
+	}
+	return raised
+}
+
+// ThreeBV returns the 3BV (Bechtel's Board Benchmark Value) of given Field,
+// which is the minimum number of clicks required to clear the field without flagging.
+//
+// Each opening, a connected region of safe cells without surrounding mines, counts as one click,
+// and each safe cell that is not revealed by any opening counts as one click.
+func ThreeBV(field *Field) int {
+	visited := make([][]bool, field.Height)
+	for i := range visited {
+		visited[i] = make([]bool, field.Width)
+	}
+
+	var reveal func(coord *Coordinate)
+	reveal = func(coord *Coordinate) {
+		for _, c := range field.getSurroundingCoordinates(coord) {
+			if visited[c.Y][c.X] {
+				continue
+			}
+			visited[c.Y][c.X] = true
+
+			if field.Cells[c.Y][c.X].SurroundingCnt() == 0 {
+				reveal(c)
+			}
+		}
+	}
+
+	cnt := 0
+
+	// Openings
+	for y, row := range field.Cells {
+		for x, c := range row {
+			if visited[y][x] || c.hasMine() || c.SurroundingCnt() > 0 {
+				continue
+			}
+
+			cnt++
+			visited[y][x] = true
+			reveal(&Coordinate{X: x, Y: y})
+		}
+	}
+
+	// Safe cells that are not revealed by any opening
+	for y, row := range field.Cells {
+		for x, c := range row {
+			if !visited[y][x] && !c.hasMine() {
+				cnt++
+			}
+		}
+	}
+
+	return cnt
+}
@@ -0,0 +1,241 @@
+package minesweeper
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDifficulty_String(t *testing.T) {
+	tests := []struct {
+		difficulty Difficulty
+		expected   string
+	}{
+		{
+			difficulty: Beginner,
+			expected:   "Beginner",
+		},
+		{
+			difficulty: Intermediate,
+			expected:   "Intermediate",
+		},
+		{
+			difficulty: Expert,
+			expected:   "Expert",
+		},
+		{
+			difficulty: Evil,
+			expected:   "Evil",
+		},
+		{
+			difficulty: 123,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if test.expected != "" {
+						t.Fatalf("Unexpectedly panicked for difficulty: %d", test.difficulty)
+					}
+				}
+			}()
+
+			s := test.difficulty.String()
+			if s != test.expected {
+				t.Fatalf("Expected %s, but %s was returned.", test.expected, s)
+			}
+		})
+	}
+}
+
+func TestDifficulty_MarshalJSON(t *testing.T) {
+	b, err := Expert.MarshalJSON()
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if string(b) != `"Expert"` {
+		t.Errorf("Unexpected value is returned: %s.", string(b))
+	}
+}
+
+func TestDifficulty_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Difficulty
+	}{
+		{
+			input:    `"Beginner"`,
+			expected: Beginner,
+		},
+		{
+			input:    `"Evil"`,
+			expected: Evil,
+		},
+		{
+			input: `"Dummy"`,
+		},
+		{
+			input: `1`,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			var d Difficulty
+			err := d.UnmarshalJSON([]byte(test.input))
+
+			if test.expected == 0 {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if d != test.expected {
+				t.Errorf("Unexpected difficulty is set: %s.", d.String())
+			}
+		})
+	}
+}
+
+func TestClassifyDifficulty(t *testing.T) {
+	tests := []struct {
+		field    *Field
+		expected Difficulty
+	}{
+		{
+			field: buildField(
+				"*........",
+				".........",
+				".........",
+				".........",
+				".........",
+				".........",
+				".........",
+				".........",
+				"........*",
+			),
+			expected: Beginner,
+		},
+		{
+			// Every safe cell is next to a mine, so there is no opening and 3BV is high.
+			// Guesses are also required.
+			field: buildField(
+				"*........",
+				".*..*..*.",
+				".........",
+				".........",
+				".*..*..*.",
+				".........",
+				".........",
+				".*..*..*.",
+				".........",
+			),
+			expected: Expert,
+		},
+		{
+			field: buildField(
+				"*****.......",
+				"*****.......",
+				"*****.......",
+				"*****.......",
+				"............",
+				"............",
+				"............",
+				"............",
+				"............",
+				"............",
+				"............",
+				"............",
+			),
+			expected: Intermediate,
+		},
+		{
+			field: buildField(
+				"*...*...*...*...*",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				".................",
+				"*...*...*...*...*",
+			),
+			expected: Expert,
+		},
+		{
+			field: buildField(
+				"***",
+				"...",
+				"...",
+			),
+			expected: Evil,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			difficulty := ClassifyDifficulty(test.field)
+			if difficulty != test.expected {
+				t.Errorf("Expected %s, but %s was returned.", test.expected.String(), difficulty.String())
+			}
+		})
+	}
+}
+
+func TestThreeBV(t *testing.T) {
+	tests := []struct {
+		field    *Field
+		expected int
+	}{
+		{
+			field: buildField(
+				"*..",
+				"...",
+				"...",
+			),
+			expected: 1,
+		},
+		{
+			field: buildField(
+				"..*..",
+				"..*..",
+				"..*..",
+			),
+			expected: 2,
+		},
+		{
+			field: buildField(
+				".*.",
+				"*.*",
+				".*.",
+			),
+			expected: 5,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			bv := ThreeBV(test.field)
+			if bv != test.expected {
+				t.Errorf("Expected %d, but %d was returned.", test.expected, bv)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package minesweeper
+
+import (
+	"fmt"
+)
+
+// Values of state encoding.
+// Opened cells are represented by surrounding counts from 0 to 8.
+const (
+	closedValue     = -1
+	flaggedValue    = -2
+	explodedValue   = -3
+	irrelevantValue = -4
+
+	minStateValue = irrelevantValue
+	maxStateValue = 8
+)
+
+// oneHotChannels is the number of channels of one-hot encoding:
+// opened cells with surrounding counts from 0 to 8, closed cells, flagged cells and exploded cells.
+const oneHotChannels = 12
+
+// opTypes lists OpType in the order of action space.
+var opTypes = []OpType{Open, Flag, Unflag}
+
+// EncodingOption defines signature that a functional option for encoders must satisfy.
+type EncodingOption func(*encoding)
+
+// Normalized creates EncodingOption that scales every value into the range of [0, 1].
+func Normalized() EncodingOption {
+	return func(e *encoding) {
+		e.normalized = true
+	}
+}
+
+// FrontierOnly creates EncodingOption that only encodes cells around the frontier:
+// non-opened cells next to opened cells and opened cells next to those.
+// Other cells are encoded as irrelevant; -4 with state encoding and all zeros with one-hot encoding.
+func FrontierOnly() EncodingOption {
+	return func(e *encoding) {
+		e.frontierOnly = true
+	}
+}
+
+type encoding struct {
+	normalized   bool
+	frontierOnly bool
+}
+
+// NewStateEncoder returns ObservationEncoder that encodes each cell into a single value in the same way EncodeStates does.
+// The Shape of an Observation is [height, width].
+func NewStateEncoder(options ...EncodingOption) ObservationEncoder {
+	e := &encoding{}
+	for _, opt := range options {
+		opt(e)
+	}
+
+	return func(field *Field) *Observation {
+		relevant := e.relevance(field)
+		data := make([]float64, 0, field.Width*field.Height)
+		for y, row := range field.Cells {
+			for x, c := range row {
+				v := float64(irrelevantValue)
+				if relevant[y][x] {
+					v = stateValue(c)
+				}
+
+				if e.normalized {
+					v = (v - minStateValue) / (maxStateValue - minStateValue)
+				}
+
+				data = append(data, v)
+			}
+		}
+
+		return &Observation{
+			Shape: []int{field.Height, field.Width},
+			Data:  data,
+		}
+	}
+}
+
+// NewOneHotEncoder returns ObservationEncoder that encodes each cell into a one-hot vector.
+// The Shape of an Observation is [12, height, width], where channels 0 to 8 represent opened cells with corresponding surrounding counts,
+// and channels 9, 10 and 11 represent closed, flagged and exploded cells.
+// Values are always 0 or 1, so Normalized makes no difference.
+func NewOneHotEncoder(options ...EncodingOption) ObservationEncoder {
+	e := &encoding{}
+	for _, opt := range options {
+		opt(e)
+	}
+
+	return func(field *Field) *Observation {
+		relevant := e.relevance(field)
+		size := field.Width * field.Height
+		data := make([]float64, oneHotChannels*size)
+		for y, row := range field.Cells {
+			for x, c := range row {
+				if !relevant[y][x] {
+					continue
+				}
+
+				var channel int
+				switch c.State() {
+				case Opened:
+					channel = c.SurroundingCnt()
+
+				case Closed:
+					channel = 9
+
+				case Flagged:
+					channel = 10
+
+				case Exploded:
+					channel = 11
+
+				default:
+					panic(fmt.Sprintf("unknown state is set: %d", c.State()))
+
+				}
+
+				data[channel*size+y*field.Width+x] = 1
+			}
+		}
+
+		return &Observation{
+			Shape: []int{oneHotChannels, field.Height, field.Width},
+			Data:  data,
+		}
+	}
+}
+
+func stateValue(c Cell) float64 {
+	switch c.State() {
+	case Closed:
+		return closedValue
+
+	case Opened:
+		return float64(c.SurroundingCnt())
+
+	case Flagged:
+		return flaggedValue
+
+	case Exploded:
+		return explodedValue
+
+	default:
+		panic(fmt.Sprintf("unknown state is set: %d", c.State()))
+
+	}
+}
+
+// relevance returns a grid that tells which cells are to be encoded.
+func (e *encoding) relevance(field *Field) [][]bool {
+	relevant := newBoolGrid(field.Width, field.Height)
+	for y, row := range field.Cells {
+		for x, c := range row {
+			if !e.frontierOnly {
+				relevant[y][x] = true
+				continue
+			}
+
+			if c.State() == Opened {
+				continue
+			}
+
+			// A non-opened cell next to an opened cell is on the frontier, and so are opened cells next to it.
+			for _, coord := range field.getSurroundingCoordinates(&Coordinate{X: x, Y: y}) {
+				if field.Cells[coord.Y][coord.X].State() == Opened {
+					relevant[y][x] = true
+					relevant[coord.Y][coord.X] = true
+				}
+			}
+		}
+	}
+	return relevant
+}
+
+// ActionSpaceSize returns the number of actions for given Field.
+// An action is indexed as opIndex*width*height + y*width + x, where opIndex is 0 for Open, 1 for Flag and 2 for Unflag.
+func ActionSpaceSize(field *Field) int {
+	return len(opTypes) * field.Width * field.Height
+}
+
+// EncodeAction returns the index of given Action in the action space described by ActionSpaceSize.
+func EncodeAction(field *Field, action *Action) (int, error) {
+	coord := action.Coordinate
+	if coord == nil || coord.X < 0 || coord.Y < 0 || coord.X+1 > field.Width || coord.Y+1 > field.Height {
+		return 0, ErrCoordinateOutOfRange
+	}
+
+	for i, opType := range opTypes {
+		if opType == action.OpType {
+			return i*field.Width*field.Height + coord.Y*field.Width + coord.X, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown OpType is given: %d", action.OpType)
+}
+
+// DecodeAction returns Action of given index in the action space described by ActionSpaceSize.
+func DecodeAction(field *Field, index int) (*Action, error) {
+	if index < 0 || index >= ActionSpaceSize(field) {
+		return nil, fmt.Errorf("action index is out of range: %d", index)
+	}
+
+	size := field.Width * field.Height
+	cellIndex := index % size
+	return &Action{
+		OpType:     opTypes[index/size],
+		Coordinate: &Coordinate{X: cellIndex % field.Width, Y: cellIndex / field.Width},
+	}, nil
+}
+
+// ActionMask returns a slice that tells which actions are currently applicable, indexed in the action space described by ActionSpaceSize.
+// Open and Flag are applicable to closed cells, while Unflag is applicable to flagged cells.
+func ActionMask(field *Field) []bool {
+	size := field.Width * field.Height
+	mask := make([]bool, ActionSpaceSize(field))
+	for y, row := range field.Cells {
+		for x, c := range row {
+			i := y*field.Width + x
+			switch c.State() {
+			case Closed:
+				mask[i] = true
+				mask[size+i] = true
+
+			case Flagged:
+				mask[2*size+i] = true
+
+			}
+		}
+	}
+	return mask
+}
@@ -0,0 +1,216 @@
+package minesweeper
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestNewStateEncoder(t *testing.T) {
+	// Row 0 is opened, row 1 touches it and row 2 is far from the frontier
+	field := buildField(
+		"...",
+		".*.",
+		"...",
+	)
+	for x := 0; x < 3; x++ {
+		field.Cells[0][x].(*cell).state = Opened
+	}
+	field.Cells[1][0].(*cell).state = Flagged
+
+	tests := []struct {
+		options []EncodingOption
+		data    []float64
+	}{
+		{
+			options: nil,
+			data: []float64{
+				1, 1, 1,
+				-2, -1, -1,
+				-1, -1, -1,
+			},
+		},
+		{
+			options: []EncodingOption{FrontierOnly()},
+			data: []float64{
+				1, 1, 1,
+				-2, -1, -1,
+				-4, -4, -4,
+			},
+		},
+		{
+			options: []EncodingOption{Normalized()},
+			data: []float64{
+				5.0 / 12, 5.0 / 12, 5.0 / 12,
+				2.0 / 12, 3.0 / 12, 3.0 / 12,
+				3.0 / 12, 3.0 / 12, 3.0 / 12,
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			observation := NewStateEncoder(test.options...)(field)
+
+			if !reflect.DeepEqual(observation.Shape, []int{3, 3}) {
+				t.Errorf("Unexpected shape is returned: %v.", observation.Shape)
+			}
+
+			if !reflect.DeepEqual(observation.Data, test.data) {
+				t.Errorf("Unexpected data is returned: %v.", observation.Data)
+			}
+		})
+	}
+}
+
+func TestNewOneHotEncoder(t *testing.T) {
+	field := buildField(
+		"..",
+		"*.",
+		"..",
+	)
+	field.Cells[0][0].(*cell).state = Opened
+	field.Cells[0][1].(*cell).state = Opened
+	field.Cells[1][0].(*cell).state = Exploded
+
+	tests := []struct {
+		options []EncodingOption
+		// hot maps a channel to indexes of cells that are 1
+		hot map[int][]int
+	}{
+		{
+			options: nil,
+			hot: map[int][]int{
+				1:  {0, 1},
+				9:  {3, 4, 5},
+				11: {2},
+			},
+		},
+		{
+			options: []EncodingOption{FrontierOnly()},
+			hot: map[int][]int{
+				1:  {0, 1},
+				9:  {3},
+				11: {2},
+			},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			observation := NewOneHotEncoder(test.options...)(field)
+
+			if !reflect.DeepEqual(observation.Shape, []int{12, 3, 2}) {
+				t.Fatalf("Unexpected shape is returned: %v.", observation.Shape)
+			}
+
+			expected := make([]float64, 12*6)
+			for channel, indexes := range test.hot {
+				for _, index := range indexes {
+					expected[channel*6+index] = 1
+				}
+			}
+
+			if !reflect.DeepEqual(observation.Data, expected) {
+				t.Errorf("Unexpected data is returned: %v.", observation.Data)
+			}
+		})
+	}
+}
+
+func TestEncodeAction(t *testing.T) {
+	field := buildField(
+		"...",
+		"...",
+	)
+
+	tests := []struct {
+		action *Action
+		index  int
+		err    bool
+	}{
+		{
+			action: &Action{OpType: Open, Coordinate: &Coordinate{X: 2, Y: 1}},
+			index:  5,
+		},
+		{
+			action: &Action{OpType: Flag, Coordinate: &Coordinate{X: 0, Y: 0}},
+			index:  6,
+		},
+		{
+			action: &Action{OpType: Unflag, Coordinate: &Coordinate{X: 1, Y: 1}},
+			index:  16,
+		},
+		{
+			action: &Action{OpType: Open, Coordinate: &Coordinate{X: 3, Y: 0}},
+			err:    true,
+		},
+		{
+			action: &Action{OpType: Open},
+			err:    true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			index, err := EncodeAction(field, test.action)
+
+			if test.err {
+				if err == nil {
+					t.Error("Expected error is not returned.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if index != test.index {
+				t.Errorf("Unexpected index is returned: %d.", index)
+			}
+
+			action, err := DecodeAction(field, index)
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if !reflect.DeepEqual(action, test.action) {
+				t.Errorf("Decoded action differs: %+v.", action)
+			}
+		})
+	}
+}
+
+func TestDecodeAction(t *testing.T) {
+	field := buildField(
+		"...",
+		"...",
+	)
+
+	for _, index := range []int{-1, ActionSpaceSize(field)} {
+		_, err := DecodeAction(field, index)
+		if err == nil {
+			t.Errorf("Expected error is not returned for %d.", index)
+		}
+	}
+}
+
+func TestActionMask(t *testing.T) {
+	field := buildField(
+		"..",
+	)
+	field.Cells[0][0].(*cell).state = Opened
+	field.Cells[0][1].(*cell).state = Flagged
+
+	mask := ActionMask(field)
+
+	expected := []bool{
+		false, false, // Open
+		false, false, // Flag
+		false, true, // Unflag
+	}
+	if !reflect.DeepEqual(mask, expected) {
+		t.Errorf("Unexpected mask is returned: %v.", mask)
+	}
+}
@@ -0,0 +1,222 @@
+package minesweeper
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrEnvNotReset is returned when Env.Step is called before Env.Reset.
+	ErrEnvNotReset = errors.New("environment is not reset")
+)
+
+// Action represents an operation an agent applies to Env.
+type Action struct {
+	OpType     OpType
+	Coordinate *Coordinate
+}
+
+// valid tells if this Action has a known OpType and a Coordinate, so it can be passed to Game.
+func (a *Action) valid() bool {
+	if a == nil || a.Coordinate == nil {
+		return false
+	}
+
+	switch a.OpType {
+	case Open, Flag, Unflag:
+		return true
+
+	default:
+		return false
+
+	}
+}
+
+// Observation is a numeric representation of a field that is fed to an agent.
+// Data is laid out in row-major order of Shape; e.g. Data[y*width+x] for a Shape of [height, width].
+type Observation struct {
+	Shape []int
+	Data  []float64
+}
+
+// ObservationEncoder converts a Field into an Observation.
+// The encoder must only refer to information visible to a player.
+type ObservationEncoder func(*Field) *Observation
+
+// EncodeStates is the default ObservationEncoder.
+// This returns an Observation with a Shape of [height, width] where an opened cell is represented by its surrounding count,
+// a closed cell by -1, a flagged cell by -2 and an exploded cell by -3.
+func EncodeStates(field *Field) *Observation {
+	data := make([]float64, 0, field.Width*field.Height)
+	for _, row := range field.Cells {
+		for _, c := range row {
+			data = append(data, stateValue(c))
+		}
+	}
+
+	return &Observation{
+		Shape: []int{field.Height, field.Width},
+		Data:  data,
+	}
+}
+
+// Rewards defines rewards given to an agent on each Env.Step.
+type Rewards struct {
+	// Progress is given when an action opens at least one cell and the game is still in progress.
+	Progress float64 `json:"progress" yaml:"progress"`
+
+	// Cleared is given when the game is cleared.
+	Cleared float64 `json:"cleared" yaml:"cleared"`
+
+	// Lost is given when the game is lost.
+	Lost float64 `json:"lost" yaml:"lost"`
+
+	// Invalid is given when the action can not be applied such as opening an opened cell.
+	Invalid float64 `json:"invalid" yaml:"invalid"`
+}
+
+// NewRewards construct Rewards with default values.
+// Use json.Unmarshal, yaml.Unmarshal or manual manipulation to override default values.
+func NewRewards() *Rewards {
+	return &Rewards{
+		Progress: 1,
+		Cleared:  10,
+		Lost:     -10,
+		Invalid:  -1,
+	}
+}
+
+// EnvOption defines signature that a functional option for Env's constructor must satisfy.
+type EnvOption func(*Env) error
+
+// WithEncoder creates EnvOption that feeds given ObservationEncoder to Env.
+func WithEncoder(encoder ObservationEncoder) EnvOption {
+	return func(e *Env) error {
+		e.encoder = encoder
+		return nil
+	}
+}
+
+// WithRewards creates EnvOption that feeds given Rewards to Env.
+func WithRewards(rewards *Rewards) EnvOption {
+	return func(e *Env) error {
+		e.rewards = rewards
+		return nil
+	}
+}
+
+// WithGameOptions creates EnvOption that passes given GameOption to NewGame on each Env.Reset.
+func WithGameOptions(options ...GameOption) EnvOption {
+	return func(e *Env) error {
+		e.gameOptions = options
+		return nil
+	}
+}
+
+// WithActor creates EnvOption that records operations applied by Env.Step with given Actor,
+// when an AuditLogger is given via WithGameOptions and WithAuditLogger.
+func WithActor(actor *Actor) EnvOption {
+	return func(e *Env) error {
+		e.actor = actor
+		return nil
+	}
+}
+
+// Env exposes Game as a reinforcement learning environment in the style of OpenAI Gym.
+// Call Reset to start an episode and Step to apply an Action.
+type Env struct {
+	config      *Config
+	encoder     ObservationEncoder
+	rewards     *Rewards
+	gameOptions []GameOption
+	actor       *Actor
+	game        *Game
+}
+
+// NewEnv is a constructor for Env.
+// Given Config is used to construct a new Game on each Env.Reset.
+func NewEnv(config *Config, options ...EnvOption) (*Env, error) {
+	env := &Env{
+		config: config,
+	}
+
+	for _, opt := range options {
+		err := opt(env)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply EnvOption: %s", err.Error())
+		}
+	}
+
+	if env.encoder == nil {
+		env.encoder = EncodeStates
+	}
+
+	if env.rewards == nil {
+		env.rewards = NewRewards()
+	}
+
+	return env, nil
+}
+
+// Reset starts a new episode with a new Game and returns the initial Observation.
+func (e *Env) Reset() (*Observation, error) {
+	game, err := NewGame(e.config, e.gameOptions...)
+	if err != nil {
+		return nil, err
+	}
+	e.game = game
+
+	return e.encoder(game.field), nil
+}
+
+// Step applies given Action and returns the resulting Observation, reward and whether the episode is done.
+//
+// An Action that can not be applied, such as opening an opened cell, results in Rewards.Invalid without changing the game.
+// So does a nil Action, an Action without Coordinate and an Action with an unknown OpType.
+// ErrOperatingFinishedGame is returned when Step is called after the episode is done.
+func (e *Env) Step(action *Action) (*Observation, float64, bool, error) {
+	if e.game == nil {
+		return nil, 0, false, ErrEnvNotReset
+	}
+
+	if e.game.state != InProgress {
+		return nil, 0, true, ErrOperatingFinishedGame
+	}
+
+	e.game.touch()
+
+	if !action.valid() {
+		return e.encoder(e.game.field), e.rewards.Invalid, false, nil
+	}
+
+	before := e.game.field.openedCnt()
+	state, err := e.game.operateAs(e.actor, action.OpType, action.Coordinate)
+	observation := e.encoder(e.game.field)
+	if err != nil {
+		return observation, e.rewards.Invalid, false, nil
+	}
+
+	switch state {
+	case Cleared:
+		return observation, e.rewards.Cleared, true, nil
+
+	case Lost:
+		return observation, e.rewards.Lost, true, nil
+
+	default:
+		if e.game.field.openedCnt() > before {
+			return observation, e.rewards.Progress, false, nil
+		}
+		return observation, 0, false, nil
+
+	}
+}
+
+// ActionMask returns applicable actions of the current game in the layout described by ActionSpaceSize.
+func (e *Env) ActionMask() ([]bool, error) {
+	if e.game == nil {
+		return nil, ErrEnvNotReset
+	}
+
+	return ActionMask(e.game.field), nil
+}
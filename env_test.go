@@ -0,0 +1,351 @@
+package minesweeper
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEncodeStates(t *testing.T) {
+	field := &Field{
+		Width:  2,
+		Height: 2,
+		Cells: [][]Cell{
+			{
+				&cell{state: Closed},
+				&cell{state: Opened, surroundingCnt: 3},
+			},
+			{
+				&cell{state: Flagged},
+				&cell{state: Exploded},
+			},
+		},
+	}
+
+	observation := EncodeStates(field)
+
+	if !reflect.DeepEqual(observation.Shape, []int{2, 2}) {
+		t.Errorf("Unexpected shape is returned: %v.", observation.Shape)
+	}
+
+	if !reflect.DeepEqual(observation.Data, []float64{-1, 3, -2, -3}) {
+		t.Errorf("Unexpected data is returned: %v.", observation.Data)
+	}
+}
+
+func TestNewRewards(t *testing.T) {
+	rewards := NewRewards()
+
+	if rewards.Cleared <= 0 {
+		t.Errorf("Clearing should be rewarded: %f.", rewards.Cleared)
+	}
+
+	if rewards.Lost >= 0 {
+		t.Errorf("Losing should be penalized: %f.", rewards.Lost)
+	}
+}
+
+func TestWithEncoder(t *testing.T) {
+	env := &Env{}
+	encoder := func(_ *Field) *Observation { return nil }
+
+	err := WithEncoder(encoder)(env)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if env.encoder == nil {
+		t.Error("Encoder is not set.")
+	}
+}
+
+func TestWithRewards(t *testing.T) {
+	env := &Env{}
+	rewards := &Rewards{}
+
+	err := WithRewards(rewards)(env)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if env.rewards != rewards {
+		t.Error("Rewards is not set.")
+	}
+}
+
+func TestWithGameOptions(t *testing.T) {
+	env := &Env{}
+
+	err := WithGameOptions(WithLives(2), WithAutoFlag())(env)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if len(env.gameOptions) != 2 {
+		t.Errorf("Unexpected number of options are set: %d.", len(env.gameOptions))
+	}
+}
+
+func TestWithActor(t *testing.T) {
+	env := &Env{}
+	actor := &Actor{ID: "agent1"}
+
+	err := WithActor(actor)(env)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if env.actor != actor {
+		t.Errorf("Given actor is not set: %#v.", env.actor)
+	}
+}
+
+func TestNewEnv(t *testing.T) {
+	tests := []struct {
+		options  []EnvOption
+		hasError bool
+	}{
+		{
+			options: nil,
+		},
+		{
+			options:  []EnvOption{func(_ *Env) error { return errors.New("dummy") }},
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			env, err := NewEnv(NewConfig(), test.options...)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if env.encoder == nil {
+				t.Error("Default encoder is not set.")
+			}
+
+			if env.rewards == nil {
+				t.Error("Default rewards is not set.")
+			}
+		})
+	}
+}
+
+func TestEnv_Reset(t *testing.T) {
+	config := &Config{Field: &FieldConfig{Width: 4, Height: 3, MineCnt: 2}}
+	env, _ := NewEnv(config)
+
+	observation, err := env.Reset()
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !reflect.DeepEqual(observation.Shape, []int{3, 4}) {
+		t.Errorf("Unexpected shape is returned: %v.", observation.Shape)
+	}
+
+	if env.game == nil {
+		t.Error("Game is not started.")
+	}
+
+	invalid, _ := NewEnv(&Config{Field: &FieldConfig{}})
+	_, err = invalid.Reset()
+	if err == nil {
+		t.Error("Expected error is not returned.")
+	}
+}
+
+func TestEnv_Step(t *testing.T) {
+	rewards := NewRewards()
+	newEnv := func() *Env {
+		return &Env{
+			encoder: EncodeStates,
+			rewards: rewards,
+			game: &Game{
+				field: buildField(
+					"*...",
+					"....",
+					"...*",
+				),
+				state: InProgress,
+				quota: 10,
+			},
+		}
+	}
+
+	tests := []struct {
+		actions []*Action
+		reward  float64
+		done    bool
+	}{
+		{
+			actions: []*Action{{OpType: Open, Coordinate: &Coordinate{X: 3, Y: 0}}},
+			reward:  rewards.Progress,
+		},
+		{
+			actions: []*Action{{OpType: Flag, Coordinate: &Coordinate{X: 0, Y: 0}}},
+			reward:  0,
+		},
+		{
+			actions: []*Action{{OpType: Open, Coordinate: &Coordinate{X: 10, Y: 0}}},
+			reward:  rewards.Invalid,
+		},
+		{
+			actions: []*Action{nil},
+			reward:  rewards.Invalid,
+		},
+		{
+			actions: []*Action{{OpType: Open}},
+			reward:  rewards.Invalid,
+		},
+		{
+			actions: []*Action{{OpType: 999, Coordinate: &Coordinate{X: 3, Y: 0}}},
+			reward:  rewards.Invalid,
+		},
+		{
+			actions: []*Action{{OpType: Open, Coordinate: &Coordinate{X: 0, Y: 0}}},
+			reward:  rewards.Lost,
+			done:    true,
+		},
+		{
+			actions: []*Action{
+				{OpType: Open, Coordinate: &Coordinate{X: 3, Y: 0}},
+				{OpType: Open, Coordinate: &Coordinate{X: 0, Y: 2}},
+			},
+			reward: rewards.Cleared,
+			done:   true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			env := newEnv()
+
+			var reward float64
+			var done bool
+			for _, action := range test.actions {
+				observation, r, d, err := env.Step(action)
+				if err != nil {
+					t.Fatalf("Unexpected error is returned: %s.", err.Error())
+				}
+
+				if observation == nil {
+					t.Fatal("Observation is not returned.")
+				}
+
+				reward = r
+				done = d
+			}
+
+			if reward != test.reward {
+				t.Errorf("Expected reward of %f, but was %f.", test.reward, reward)
+			}
+
+			if done != test.done {
+				t.Errorf("Unexpected done flag: %t.", done)
+			}
+
+			if done {
+				_, _, _, err := env.Step(test.actions[0])
+				if err != ErrOperatingFinishedGame {
+					t.Errorf("Expected error is not returned: %v.", err)
+				}
+			}
+		})
+	}
+
+	_, _, _, err := (&Env{}).Step(&Action{})
+	if err != ErrEnvNotReset {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
+
+func TestEnv_Step_Audit(t *testing.T) {
+	logger := &DummyAuditLogger{}
+	actor := &Actor{ID: "agent1", Source: "bot"}
+	env := &Env{
+		encoder: EncodeStates,
+		rewards: NewRewards(),
+		actor:   actor,
+		game: &Game{
+			field:       buildField("*..."),
+			state:       InProgress,
+			quota:       3,
+			auditLogger: logger,
+		},
+	}
+
+	_, _, _, err := env.Step(&Action{OpType: Open, Coordinate: &Coordinate{X: 3, Y: 0}})
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if len(logger.Entries) != 1 {
+		t.Fatalf("Unexpected number of entries are logged: %d.", len(logger.Entries))
+	}
+
+	entry := logger.Entries[0]
+	if entry.Actor != actor || entry.Action != AuditOperate || entry.Detail != "Open 3:0" {
+		t.Errorf("Unexpected entry is logged: %#v.", entry)
+	}
+}
+
+func TestEnv_Step_IdlePause(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	env := &Env{
+		encoder: EncodeStates,
+		rewards: NewRewards(),
+		game: &Game{
+			field:         buildField("*...."),
+			state:         InProgress,
+			quota:         4,
+			clock:         func() time.Time { return now },
+			idleThreshold: 10 * time.Second,
+		},
+	}
+
+	_, _, _, _ = env.Step(&Action{OpType: Flag, Coordinate: &Coordinate{X: 0, Y: 0}})
+	now = now.Add(5 * time.Second)
+	_, _, _, _ = env.Step(&Action{OpType: Unflag, Coordinate: &Coordinate{X: 0, Y: 0}})
+
+	if env.game.ActiveTime() != 5*time.Second {
+		t.Errorf("Steps are not taken as input: %s.", env.game.ActiveTime())
+	}
+}
+
+func TestEnv_ActionMask(t *testing.T) {
+	env, _ := NewEnv(&Config{Field: &FieldConfig{Width: 4, Height: 3, MineCnt: 2}})
+
+	_, err := env.ActionMask()
+	if err != ErrEnvNotReset {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+
+	_, _ = env.Reset()
+	mask, err := env.ActionMask()
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if len(mask) != 36 {
+		t.Errorf("Unexpected mask length: %d.", len(mask))
+	}
+}
@@ -0,0 +1,119 @@
+package minesweeper
+
+import (
+	"fmt"
+)
+
+// Event represents something that happened in a game.
+// Use type switch to distinguish concrete event types such as *OperatedEvent, *LifeLostEvent and *FinishedEvent.
+type Event interface {
+	event()
+}
+
+// OperatedEvent is emitted when an operation is successfully applied to a cell.
+type OperatedEvent struct {
+	OpType     OpType
+	Coordinate *Coordinate
+	NewState   CellState
+}
+
+func (*OperatedEvent) event() {}
+
+// LifeLostEvent is emitted when a mine exploded but the game continues because a life remains.
+type LifeLostEvent struct {
+	Coordinate *Coordinate
+	Remaining  int
+}
+
+func (*LifeLostEvent) event() {}
+
+// FeedbackEvent is emitted on each successful operation while blind mode is enabled via WithBlindMode.
+// This carries what a user needs to know without seeing the field, so frontends such as audio ones can present it.
+type FeedbackEvent struct {
+	OpType     OpType
+	Coordinate *Coordinate
+	NewState   CellState
+
+	// SurroundingCnt is the number of mines around the operated cell when it is opened; zero otherwise.
+	SurroundingCnt int
+
+	// CascadeCnt is the number of cells opened by the operation including those opened in a cascade.
+	CascadeCnt int
+
+	// NearbyFlagCnt is the number of flagged cells around the operated cell.
+	NearbyFlagCnt int
+}
+
+func (*FeedbackEvent) event() {}
+
+// FinishedEvent is emitted when a game is finished.
+// This is the last event of a game and the channel returned by Game.Events is closed right after this.
+type FinishedEvent struct {
+	State GameState
+}
+
+func (*FinishedEvent) event() {}
+
+// EventBufferPolicy depicts how an event is handled when the buffer of the event channel is full.
+type EventBufferPolicy int
+
+const (
+	_ EventBufferPolicy = iota
+
+	// BlockOnFull represents a policy where Game.Operate blocks until the consumer receives an event and the buffer has space.
+	// No event is lost with this policy.
+	BlockOnFull
+
+	// DropOnFull represents a policy where an event is discarded when the buffer is full.
+	// Game.Operate never blocks with this policy, but a slow consumer may miss events.
+	DropOnFull
+)
+
+// WithEvents creates GameOption that enables event emission via the channel returned by Game.Events.
+// The channel has a buffer of given size, and policy decides what to do when the buffer is full.
+// Events are sent in the order they happen.
+func WithEvents(bufferSize int, policy EventBufferPolicy) GameOption {
+	return func(g *Game) error {
+		if bufferSize < 0 {
+			return fmt.Errorf("buffer size must not be negative: %d", bufferSize)
+		}
+
+		if policy != BlockOnFull && policy != DropOnFull {
+			return fmt.Errorf("unknown buffer policy is given: %d", policy)
+		}
+
+		g.events = make(chan Event, bufferSize)
+		g.eventPolicy = policy
+		return nil
+	}
+}
+
+// Events returns a channel that emits events of this game.
+//
+// This returns nil unless WithEvents is given on construction.
+// The channel is closed when the game is finished.
+func (g *Game) Events() <-chan Event {
+	return g.events
+}
+
+func (g *Game) emit(e Event) {
+	if g.events == nil {
+		return
+	}
+
+	switch g.eventPolicy {
+	case DropOnFull:
+		select {
+		case g.events <- e:
+		default:
+		}
+
+	default:
+		g.events <- e
+
+	}
+
+	if _, ok := e.(*FinishedEvent); ok {
+		close(g.events)
+	}
+}
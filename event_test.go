@@ -0,0 +1,218 @@
+package minesweeper
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestWithEvents(t *testing.T) {
+	tests := []struct {
+		bufferSize int
+		policy     EventBufferPolicy
+		hasError   bool
+	}{
+		{
+			bufferSize: 10,
+			policy:     BlockOnFull,
+		},
+		{
+			bufferSize: 0,
+			policy:     DropOnFull,
+		},
+		{
+			bufferSize: -1,
+			policy:     BlockOnFull,
+			hasError:   true,
+		},
+		{
+			bufferSize: 10,
+			policy:     123,
+			hasError:   true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{}
+			err := WithEvents(test.bufferSize, test.policy)(game)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.Events() == nil {
+				t.Fatal("Event channel is not set.")
+			}
+
+			if cap(game.events) != test.bufferSize {
+				t.Errorf("Unexpected buffer size: %d.", cap(game.events))
+			}
+
+			if game.eventPolicy != test.policy {
+				t.Errorf("Unexpected policy is set: %d.", game.eventPolicy)
+			}
+		})
+	}
+}
+
+func TestGame_Events(t *testing.T) {
+	game := &Game{}
+	if game.Events() != nil {
+		t.Error("Channel should not be returned when events are not enabled.")
+	}
+}
+
+func TestGame_emit(t *testing.T) {
+	t.Run("drop on full", func(t *testing.T) {
+		game := &Game{
+			events:      make(chan Event, 1),
+			eventPolicy: DropOnFull,
+		}
+
+		game.emit(&OperatedEvent{OpType: Flag})
+		game.emit(&OperatedEvent{OpType: Unflag})
+
+		e := <-game.Events()
+		if e.(*OperatedEvent).OpType != Flag {
+			t.Errorf("Unexpected event is received: %#v.", e)
+		}
+
+		select {
+		case e := <-game.Events():
+			t.Errorf("Event should be dropped: %#v.", e)
+		default:
+		}
+	})
+
+	t.Run("close on finish", func(t *testing.T) {
+		game := &Game{
+			events:      make(chan Event, 1),
+			eventPolicy: BlockOnFull,
+		}
+
+		game.emit(&FinishedEvent{State: Cleared})
+
+		e := <-game.Events()
+		if e.(*FinishedEvent).State != Cleared {
+			t.Errorf("Unexpected event is received: %#v.", e)
+		}
+
+		_, ok := <-game.Events()
+		if ok {
+			t.Error("Channel is not closed.")
+		}
+	})
+}
+
+func TestGame_Operate_Events(t *testing.T) {
+	coords := []*Coordinate{{X: 0, Y: 0}, {X: 2, Y: 0}, {X: 1, Y: 0}}
+	game := &Game{
+		ui: &DummyUI{
+			ParseInputFunc: func(_ []byte) (OpType, *Coordinate, error) {
+				coord := coords[0]
+				coords = coords[1:]
+				return Open, coord, nil
+			},
+		},
+		field: &Field{
+			Width:  3,
+			Height: 1,
+			Cells: [][]Cell{
+				{
+					&cell{state: Closed, mine: true, surroundingCnt: 0},
+					&cell{state: Closed, mine: false, surroundingCnt: 2},
+					&cell{state: Closed, mine: true, surroundingCnt: 0},
+				},
+			},
+		},
+		state:       InProgress,
+		quota:       1,
+		lives:       3,
+		events:      make(chan Event, 10),
+		eventPolicy: BlockOnFull,
+	}
+
+	for range []int{1, 2, 3} {
+		_, err := game.Operate([]byte("dummy"))
+		if err != nil {
+			t.Fatalf("Unexpected error is returned: %s.", err.Error())
+		}
+	}
+
+	var events []Event
+	for e := range game.Events() {
+		events = append(events, e)
+	}
+
+	expected := []string{"Exploded", "LifeLost", "Exploded", "LifeLost", "Opened", "Finished"}
+	if len(events) != len(expected) {
+		t.Fatalf("Unexpected number of events: %d.", len(events))
+	}
+
+	for i, e := range events {
+		var name string
+		switch typed := e.(type) {
+		case *OperatedEvent:
+			name = typed.NewState.String()
+
+		case *LifeLostEvent:
+			name = "LifeLost"
+
+		case *FinishedEvent:
+			name = "Finished"
+
+		}
+
+		if name != expected[i] {
+			t.Errorf("Expected event #%d to be %s, but was %s.", i+1, expected[i], name)
+		}
+	}
+}
+
+func TestGame_Operate_FeedbackEvent(t *testing.T) {
+	field := buildField(
+		"*...",
+		"....",
+	)
+	field.Cells[0][0].(*cell).state = Flagged
+	game := &Game{
+		field:       field,
+		state:       InProgress,
+		quota:       7,
+		blind:       true,
+		events:      make(chan Event, 10),
+		eventPolicy: BlockOnFull,
+	}
+
+	_, err := game.operate(Open, &Coordinate{X: 1, Y: 1})
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	<-game.Events() // OperatedEvent
+	e, ok := (<-game.Events()).(*FeedbackEvent)
+	if !ok {
+		t.Fatalf("Unexpected event is received: %#v.", e)
+	}
+
+	expected := &FeedbackEvent{
+		OpType:         Open,
+		Coordinate:     &Coordinate{X: 1, Y: 1},
+		NewState:       Opened,
+		SurroundingCnt: 1,
+		CascadeCnt:     1,
+		NearbyFlagCnt:  1,
+	}
+	if !reflect.DeepEqual(e, expected) {
+		t.Errorf("Unexpected feedback is received: %#v.", e)
+	}
+}
@@ -1,11 +1,13 @@
 package minesweeper
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/tidwall/gjson"
 	"math/rand"
+	"strings"
 )
 
 var (
@@ -56,32 +58,51 @@ type Field struct {
 	Width  int
 	Height int
 	Cells  [][]Cell
+
+	// SafeStart is a recommended cell to start with, which is certainly safe. This is nil unless given by a board code or JSON.
+	SafeStart *Coordinate
 }
 
 // NewField construct a Field with given configuration.
 func NewField(config *FieldConfig) (*Field, error) {
+	return newField(config, rand.Perm)
+}
+
+// NewSeededField constructs a Field with given configuration and places mines deterministically from given seed.
+// The same seed and configuration always result in the same mine placement.
+func NewSeededField(config *FieldConfig, seed int64) (*Field, error) {
+	return newField(config, rand.New(rand.NewSource(seed)).Perm)
+}
+
+// newField constructs a Field with mines placed by given perm, which returns a permutation of [0, n) like rand.Perm.
+func newField(config *FieldConfig, perm func(int) []int) (*Field, error) {
 	if err := validateConfig(config); err != nil {
 		return nil, fmt.Errorf("invalild config is given: %s", err.Error())
 	}
 
-	grid := func() [][]bool {
-		n := config.Width * config.Height
-		mines := make([]bool, n)
-		for _, v := range rand.Perm(n)[:config.MineCnt] {
-			mines[v] = true
-		}
+	n := config.Width * config.Height
+	mines := make([]bool, n)
+	for _, v := range perm(n)[:config.MineCnt] {
+		mines[v] = true
+	}
 
-		grid := make([][]bool, config.Height)
-		for i := 0; i < config.Height; i++ {
-			start := i * config.Width
-			grid[i] = mines[start : start+config.Width]
-		}
-		return grid
-	}()
+	grid := make([][]bool, config.Height)
+	for i := 0; i < config.Height; i++ {
+		start := i * config.Width
+		grid[i] = mines[start : start+config.Width]
+	}
+
+	return newFieldFromGrid(grid), nil
+}
+
+// newFieldFromGrid constructs a Field from given grid, where true represents a cell with a mine.
+func newFieldFromGrid(grid [][]bool) *Field {
+	height := len(grid)
+	width := len(grid[0])
 
-	cells := make([][]Cell, config.Height)
+	cells := make([][]Cell, height)
 	for i, row := range grid {
-		cells[i] = make([]Cell, config.Width)
+		cells[i] = make([]Cell, width)
 
 		for ii, hasMine := range row {
 			var surroundingCnt int
@@ -96,7 +117,7 @@ func NewField(config *FieldConfig) (*Field, error) {
 					surroundingCnt++
 				}
 
-				if ii+1 < config.Width && above[ii+1] {
+				if ii+1 < width && above[ii+1] {
 					surroundingCnt++
 				}
 			}
@@ -105,11 +126,11 @@ func NewField(config *FieldConfig) (*Field, error) {
 				surroundingCnt++
 			}
 
-			if ii+1 < config.Width && row[ii+1] {
+			if ii+1 < width && row[ii+1] {
 				surroundingCnt++
 			}
 
-			if i+1 < config.Height {
+			if i+1 < height {
 				below := grid[i+1]
 				if ii > 0 && below[ii-1] {
 					surroundingCnt++
@@ -119,7 +140,7 @@ func NewField(config *FieldConfig) (*Field, error) {
 					surroundingCnt++
 				}
 
-				if ii+1 < config.Width && below[ii+1] {
+				if ii+1 < width && below[ii+1] {
 					surroundingCnt++
 				}
 			}
@@ -129,10 +150,123 @@ func NewField(config *FieldConfig) (*Field, error) {
 	}
 
 	return &Field{
-		Width:  config.Width,
-		Height: config.Height,
+		Width:  width,
+		Height: height,
 		Cells:  cells,
-	}, nil
+	}
+}
+
+// maxInt is the largest value of int, which is not provided by math package before Go 1.17.
+const maxInt = int(^uint(0) >> 1)
+
+// NewFieldFromCode constructs a Field from given board code, which is returned by Field.Code.
+// Every cell of the constructed Field is closed.
+// A board that a FieldConfig can not describe, such as one without any mine or one filled with mines, is rejected.
+// An error is also returned when the code designates a safe start cell that is out of range or mined.
+func NewFieldFromCode(code string) (*Field, error) {
+	var start *Coordinate
+	if i := strings.Index(code, "@"); i >= 0 {
+		start = &Coordinate{}
+		_, err := fmt.Sscanf(code[i+1:], "%d,%d", &start.X, &start.Y)
+		if err != nil || fmt.Sprintf("%d,%d", start.X, start.Y) != code[i+1:] {
+			return nil, fmt.Errorf("invalid safe start is given: %s", code[i+1:])
+		}
+		code = code[:i]
+	}
+
+	var width, height int
+	parts := strings.SplitN(code, "-", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid board code is given: %s", code)
+	}
+
+	_, err := fmt.Sscanf(parts[0], "%dx%d", &width, &height)
+	if err != nil || width <= 0 || height <= 0 || fmt.Sprintf("%dx%d", width, height) != parts[0] {
+		return nil, fmt.Errorf("invalid field size is given: %s", parts[0])
+	}
+
+	// The number of cells must not overflow so the bitmap length below can bound the size of the field
+	if width > (maxInt-7)/height {
+		return nil, fmt.Errorf("field size is too large: %s", parts[0])
+	}
+	bitmap, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode mine placement: %s", err.Error())
+	}
+
+	if len(bitmap) != (width*height+7)/8 {
+		return nil, fmt.Errorf("mine placement does not match field size: %s", parts[0])
+	}
+
+	mineCnt := 0
+	grid := make([][]bool, height)
+	for y := range grid {
+		grid[y] = make([]bool, width)
+		for x := range grid[y] {
+			i := y*width + x
+			grid[y][x] = bitmap[i/8]&(0x80>>uint(i%8)) != 0
+			if grid[y][x] {
+				mineCnt++
+			}
+		}
+	}
+
+	// Bits after the last cell are padding and must be zero
+	if n := width * height; n%8 != 0 && bitmap[len(bitmap)-1]&(0xFF>>uint(n%8)) != 0 {
+		return nil, errors.New("mine placement has extra bits")
+	}
+
+	err = validateConfig(&FieldConfig{Width: width, Height: height, MineCnt: mineCnt})
+	if err != nil {
+		return nil, fmt.Errorf("invalid board code is given: %s", err.Error())
+	}
+
+	field := newFieldFromGrid(grid)
+	if start != nil {
+		err = field.validateSafeStart(start)
+		if err != nil {
+			return nil, err
+		}
+		field.SafeStart = start
+	}
+
+	return field, nil
+}
+
+// validateSafeStart checks if given Coordinate can be designated as SafeStart, that is, the cell is in range and has no mine.
+func (f *Field) validateSafeStart(start *Coordinate) error {
+	if start.X < 0 || start.Y < 0 || start.X+1 > f.Width || start.Y+1 > f.Height {
+		return fmt.Errorf("safe start is out of range: %d,%d", start.X, start.Y)
+	}
+
+	if f.Cells[start.Y][start.X].hasMine() {
+		return fmt.Errorf("safe start has a mine: %d,%d", start.X, start.Y)
+	}
+
+	return nil
+}
+
+// Code returns a board code that represents the size and mine placement of this Field in a compact form.
+// The format is "<width>x<height>-<mine bitmap>",
+// where the bitmap has a bit for each cell in row-major order, most significant bit first, and is encoded in unpadded URL-safe base64.
+// When SafeStart is set, "@<x>,<y>" is appended to designate the cell.
+// States of cells are not part of the code, so this is meant to share a board rather than an ongoing game.
+func (f *Field) Code() string {
+	bitmap := make([]byte, (f.Width*f.Height+7)/8)
+	for y, row := range f.Cells {
+		for x, c := range row {
+			if c.hasMine() {
+				i := y*f.Width + x
+				bitmap[i/8] |= 0x80 >> uint(i%8)
+			}
+		}
+	}
+
+	code := fmt.Sprintf("%dx%d-%s", f.Width, f.Height, base64.RawURLEncoding.EncodeToString(bitmap))
+	if f.SafeStart != nil {
+		code += fmt.Sprintf("@%d,%d", f.SafeStart.X, f.SafeStart.Y)
+	}
+	return code
 }
 
 // Open receives a Coordinate, locate a corresponding cell, and opens it.
@@ -147,7 +281,7 @@ func (f *Field) Open(coord *Coordinate) (*Result, error) {
 	x := coord.X
 	y := coord.Y
 
-	if x+1 > f.Width || y+1 > f.Height {
+	if x < 0 || y < 0 || x+1 > f.Width || y+1 > f.Height {
 		return nil, ErrCoordinateOutOfRange
 	}
 
@@ -161,19 +295,22 @@ func (f *Field) Open(coord *Coordinate) (*Result, error) {
 		return result, nil
 	}
 
-	f.openSurroundings(coord)
+	result.OpenedCnt = 1 + f.openSurroundings(coord)
 
 	return result, nil
 }
 
-func (f *Field) openSurroundings(coord *Coordinate) {
+// openSurroundings recursively opens surrounding cells and returns the number of opened cells.
+func (f *Field) openSurroundings(coord *Coordinate) int {
 	origin := f.Cells[coord.Y][coord.X]
 	if origin.SurroundingCnt() > 0 {
 		// At least one surrounding cell has a mine.
 		// Do not automatically open all surrounding cells.
-		return
+		return 0
 	}
 
+	cnt := 0
+
 	// All surrounding cells are safe to open.
 	for _, c := range f.getSurroundingCoordinates(coord) {
 		target := f.Cells[c.Y][c.X]
@@ -185,8 +322,10 @@ func (f *Field) openSurroundings(coord *Coordinate) {
 		}
 
 		target.open()
-		f.openSurroundings(c)
+		cnt += 1 + f.openSurroundings(c)
 	}
+
+	return cnt
 }
 
 // Flag receives a Coordinate, locate a corresponding cell, and flag it to indicate possible underlying mine.
@@ -200,7 +339,7 @@ func (f *Field) Flag(coord *Coordinate) (*Result, error) {
 	x := coord.X
 	y := coord.Y
 
-	if x+1 > f.Width || y+1 > f.Height {
+	if x < 0 || y < 0 || x+1 > f.Width || y+1 > f.Height {
 		return nil, ErrCoordinateOutOfRange
 	}
 
@@ -216,13 +355,135 @@ func (f *Field) Unflag(coord *Coordinate) (*Result, error) {
 	x := coord.X
 	y := coord.Y
 
-	if x+1 > f.Width || y+1 > f.Height {
+	if x < 0 || y < 0 || x+1 > f.Width || y+1 > f.Height {
 		return nil, ErrCoordinateOutOfRange
 	}
 
 	return f.Cells[y][x].unflag()
 }
 
+// closedSafeCnt returns the number of safe cells that are not opened yet, including wrongly flagged ones.
+func (f *Field) closedSafeCnt() int {
+	cnt := 0
+	for _, row := range f.Cells {
+		for _, c := range row {
+			if !c.hasMine() && c.State() != Opened {
+				cnt++
+			}
+		}
+	}
+	return cnt
+}
+
+func (f *Field) openedCnt() int {
+	cnt := 0
+	for _, row := range f.Cells {
+		for _, c := range row {
+			if c.State() == Opened {
+				cnt++
+			}
+		}
+	}
+	return cnt
+}
+
+// surroundingFlagCnt returns the number of flagged cells around given Coordinate.
+// Zero is returned when the Coordinate points to a non-existing field location.
+func (f *Field) surroundingFlagCnt(coord *Coordinate) int {
+	if coord.X < 0 || coord.Y < 0 || coord.X+1 > f.Width || coord.Y+1 > f.Height {
+		return 0
+	}
+
+	cnt := 0
+	for _, c := range f.getSurroundingCoordinates(coord) {
+		if f.Cells[c.Y][c.X].State() == Flagged {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// RowMineCnts returns the total number of mines in each row from top to bottom.
+// This is a hint that nonogram-style variants reveal to a user.
+func (f *Field) RowMineCnts() []int {
+	cnts := make([]int, f.Height)
+	for y, row := range f.Cells {
+		for _, c := range row {
+			if c.hasMine() {
+				cnts[y]++
+			}
+		}
+	}
+	return cnts
+}
+
+// ColumnMineCnts returns the total number of mines in each column from left to right.
+// This is a hint that nonogram-style variants reveal to a user.
+func (f *Field) ColumnMineCnts() []int {
+	cnts := make([]int, f.Width)
+	for _, row := range f.Cells {
+		for x, c := range row {
+			if c.hasMine() {
+				cnts[x]++
+			}
+		}
+	}
+	return cnts
+}
+
+// flagClosedCells flags every closed cell and returns their coordinates.
+func (f *Field) flagClosedCells() []*Coordinate {
+	var flagged []*Coordinate
+	for y, row := range f.Cells {
+		for x, c := range row {
+			if c.State() != Closed {
+				continue
+			}
+
+			c.flag()
+			flagged = append(flagged, &Coordinate{X: x, Y: y})
+		}
+	}
+	return flagged
+}
+
+// flagObviousMines flags every closed cell that is certainly mined judging from its opened neighbors, and returns their coordinates.
+// When the number of non-opened cells around an opened cell equals its surrounding count, all of those cells have mines.
+func (f *Field) flagObviousMines() []*Coordinate {
+	var flagged []*Coordinate
+	for y, row := range f.Cells {
+		for x, c := range row {
+			if c.State() != Opened || c.SurroundingCnt() == 0 {
+				continue
+			}
+
+			var closed []*Coordinate
+			hidden := 0
+			for _, coord := range f.getSurroundingCoordinates(&Coordinate{X: x, Y: y}) {
+				switch f.Cells[coord.Y][coord.X].State() {
+				case Closed:
+					closed = append(closed, coord)
+					hidden++
+
+				case Flagged, Exploded:
+					hidden++
+
+				}
+			}
+
+			if hidden != c.SurroundingCnt() {
+				continue
+			}
+
+			for _, coord := range closed {
+				f.Cells[coord.Y][coord.X].flag()
+				flagged = append(flagged, coord)
+			}
+		}
+	}
+	return flagged
+}
+
 // MarshalJSON returns JSON representation of Field.
 func (f *Field) MarshalJSON() ([]byte, error) {
 	m := map[string]interface{}{}
@@ -239,10 +500,14 @@ func (f *Field) MarshalJSON() ([]byte, error) {
 		}
 	}
 	m["cells"] = cells
+	if f.SafeStart != nil {
+		m["safe_start"] = map[string]int{"x": f.SafeStart.X, "y": f.SafeStart.Y}
+	}
 	return json.Marshal(m)
 }
 
 // UnmarshalJSON converts given input to Field instance.
+// An error is returned when cells do not match the given size, or when "safe_start" is out of range or mined in the same way as NewFieldFromCode.
 func (f *Field) UnmarshalJSON(b []byte) error {
 	res := gjson.ParseBytes(b)
 
@@ -265,10 +530,21 @@ func (f *Field) UnmarshalJSON(b []byte) error {
 	if !cellsValue.Exists() {
 		return errors.New(`"cells" field is not given`)
 	}
+	if f.Width <= 0 || f.Height <= 0 {
+		return fmt.Errorf("field size must be positive: %dx%d", f.Width, f.Height)
+	}
+	rows := cellsValue.Array()
+	if len(rows) != f.Height {
+		return fmt.Errorf("number of rows does not match height: %d", len(rows))
+	}
 	f.Cells = make([][]Cell, f.Height)
-	for i, row := range cellsValue.Array() {
+	for i, row := range rows {
+		columns := row.Array()
+		if len(columns) != f.Width {
+			return fmt.Errorf("number of cells in row %d does not match width: %d", i, len(columns))
+		}
 		cells := make([]Cell, f.Width)
-		for ii, c := range row.Array() {
+		for ii, c := range columns {
 			stateValue := c.Get("state")
 			if !stateValue.Exists() {
 				return errors.New(`"state" field is not given`)
@@ -297,6 +573,26 @@ func (f *Field) UnmarshalJSON(b []byte) error {
 		f.Cells[i] = cells
 	}
 
+	// Set safe start if any
+	startValue := res.Get("safe_start")
+	if startValue.Exists() {
+		xValue := startValue.Get("x")
+		yValue := startValue.Get("y")
+		if !xValue.Exists() || !yValue.Exists() {
+			return errors.New(`"safe_start" field must have "x" and "y"`)
+		}
+
+		start := &Coordinate{
+			X: int(xValue.Int()),
+			Y: int(yValue.Int()),
+		}
+		err := f.validateSafeStart(start)
+		if err != nil {
+			return err
+		}
+		f.SafeStart = start
+	}
+
 	// O.K.
 	return nil
 }
@@ -308,7 +604,7 @@ func (f *Field) getSurroundingCoordinates(coord *Coordinate) []*Coordinate {
 	var coords []*Coordinate
 	// Above row
 	if y > 0 {
-		if x > 1 {
+		if x > 0 {
 			coords = append(coords, &Coordinate{X: x - 1, Y: y - 1})
 		}
 
@@ -330,7 +626,7 @@ func (f *Field) getSurroundingCoordinates(coord *Coordinate) []*Coordinate {
 
 	// Below row
 	if y+1 < f.Height {
-		if x > 1 {
+		if x > 0 {
 			coords = append(coords, &Coordinate{X: x - 1, Y: y + 1})
 		}
 
@@ -353,4 +649,7 @@ type Coordinate struct {
 // Result represents a result of given action.
 type Result struct {
 	NewState CellState
+
+	// OpenedCnt is the number of cells opened by Field.Open including those opened in a cascade.
+	OpenedCnt int
 }
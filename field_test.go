@@ -3,6 +3,7 @@ package minesweeper
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -92,6 +93,164 @@ func TestNewField(t *testing.T) {
 	}
 }
 
+func TestNewSeededField(t *testing.T) {
+	config := &FieldConfig{Width: 16, Height: 16, MineCnt: 40}
+
+	field1, err := NewSeededField(config, 1)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	field2, _ := NewSeededField(config, 1)
+	if field1.Code() != field2.Code() {
+		t.Errorf("Fields differ with the same seed: %s and %s.", field1.Code(), field2.Code())
+	}
+
+	_, err = NewSeededField(&FieldConfig{}, 1)
+	if err == nil {
+		t.Error("Expected error is not returned.")
+	}
+}
+
+func TestField_Code(t *testing.T) {
+	field := buildField(
+		"*..",
+		"..*",
+		"...",
+	)
+
+	code := field.Code()
+
+	// 10000100 00000000 in bitmap
+	if code != "3x3-hAA" {
+		t.Errorf("Unexpected code is returned: %s.", code)
+	}
+
+	field.SafeStart = &Coordinate{X: 1, Y: 1}
+	code = field.Code()
+	if code != "3x3-hAA@1,1" {
+		t.Errorf("Unexpected code is returned: %s.", code)
+	}
+}
+
+func TestNewFieldFromCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected *Field
+		start    *Coordinate
+	}{
+		{
+			code: "3x3-hAA",
+			expected: buildField(
+				"*..",
+				"..*",
+				"...",
+			),
+		},
+		{
+			code: "3x3-hAA@1,1",
+			expected: buildField(
+				"*..",
+				"..*",
+				"...",
+			),
+			start: &Coordinate{X: 1, Y: 1},
+		},
+		{
+			code: "invalid",
+		},
+		{
+			// Safe start has a mine
+			code: "3x3-hAA@0,0",
+		},
+		{
+			code: "3x3-hAA@3,0",
+		},
+		{
+			code: "3x3-hAA@invalid",
+		},
+		{
+			// Trailing garbage after the safe start
+			code: "3x3-hAA@1,1x",
+		},
+		{
+			code: "3x3-hAA@1,1,2",
+		},
+		{
+			code: "AxB-hAA",
+		},
+		{
+			code: "0x3-hAA",
+		},
+		{
+			code: "3x3-!!!",
+		},
+		{
+			code: "5x5-hAA",
+		},
+		{
+			// No mine
+			code: "3x3-AAA",
+		},
+		{
+			// Every cell is mined
+			code: "2x1-wA",
+		},
+		{
+			code: "1x1-gA",
+		},
+		{
+			code: "3x3abc-hAA",
+		},
+		{
+			// Number of cells overflows
+			code: "4294967296x4294967296-",
+		},
+		{
+			code: "9223372036854775807x2-",
+		},
+		{
+			// Padding bit is set
+			code: "3x3-hAE",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			field, err := NewFieldFromCode(test.code)
+
+			if test.expected == nil {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if field.Width != test.expected.Width || field.Height != test.expected.Height {
+				t.Fatalf("Unexpected field size: %dx%d.", field.Width, field.Height)
+			}
+
+			if !reflect.DeepEqual(field.SafeStart, test.start) {
+				t.Errorf("Unexpected safe start is set: %#v.", field.SafeStart)
+			}
+
+			for y, row := range test.expected.Cells {
+				for x, c := range row {
+					actual := field.Cells[y][x]
+					if actual.hasMine() != c.hasMine() || actual.SurroundingCnt() != c.SurroundingCnt() || actual.State() != Closed {
+						t.Errorf("Unexpected cell is set on %d:%d: %#v.", x, y, actual)
+					}
+				}
+			}
+		})
+	}
+}
+
 func TestField_Flag(t *testing.T) {
 	type test struct {
 		field    *Field
@@ -556,6 +715,7 @@ func TestField_Open(t *testing.T) {
 
 			target := test.field.Cells[test.coord.Y][test.coord.X]
 			oldStatus := target.State()
+			oldOpenedCnt := test.field.openedCnt()
 
 			result, err := test.field.Open(test.coord)
 
@@ -586,6 +746,8 @@ func TestField_Open(t *testing.T) {
 				}
 			} else if result.NewState != Opened {
 				t.Fatalf("Unexpected state is returned: %s", result.NewState)
+			} else if result.OpenedCnt != test.field.openedCnt()-oldOpenedCnt {
+				t.Errorf("Unexpected number of opened cells is returned: %d", result.OpenedCnt)
 			}
 
 			for i, row := range test.field.Cells {
@@ -599,6 +761,133 @@ func TestField_Open(t *testing.T) {
 	}
 }
 
+func TestField_surroundingFlagCnt(t *testing.T) {
+	field := &Field{
+		Width:  3,
+		Height: 2,
+		Cells: [][]Cell{
+			{
+				&cell{state: Flagged},
+				&cell{state: Closed},
+				&cell{state: Flagged},
+			},
+			{
+				&cell{state: Opened},
+				&cell{state: Flagged},
+				&cell{state: Closed},
+			},
+		},
+	}
+
+	tests := []struct {
+		coord    *Coordinate
+		expected int
+	}{
+		{
+			coord:    &Coordinate{X: 1, Y: 0},
+			expected: 3,
+		},
+		{
+			coord:    &Coordinate{X: 0, Y: 1},
+			expected: 2,
+		},
+		{
+			coord:    &Coordinate{X: 3, Y: 0},
+			expected: 0,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			cnt := field.surroundingFlagCnt(test.coord)
+			if cnt != test.expected {
+				t.Errorf("Expected %d, but was %d.", test.expected, cnt)
+			}
+		})
+	}
+}
+
+func TestField_flagObviousMines(t *testing.T) {
+	field := &Field{
+		Width:  3,
+		Height: 2,
+		Cells: [][]Cell{
+			{
+				&cell{state: Closed, mine: true, surroundingCnt: 1},
+				&cell{state: Opened, mine: false, surroundingCnt: 2},
+				&cell{state: Closed, mine: false, surroundingCnt: 2},
+			},
+			{
+				&cell{state: Opened, mine: false, surroundingCnt: 2},
+				&cell{state: Flagged, mine: true, surroundingCnt: 2},
+				&cell{state: Closed, mine: false, surroundingCnt: 1},
+			},
+		},
+	}
+
+	flagged := field.flagObviousMines()
+
+	if len(flagged) != 1 || *flagged[0] != (Coordinate{X: 0, Y: 0}) {
+		t.Errorf("Unexpected coordinates are returned: %s.", coordsString(flagged))
+	}
+
+	expected := [][]CellState{
+		{Flagged, Opened, Closed},
+		{Opened, Flagged, Closed},
+	}
+	for y, row := range expected {
+		for x, state := range row {
+			actual := field.Cells[y][x].State()
+			if actual != state {
+				t.Errorf("Expected state of %d:%d to be %s, but was %s.", x, y, state.String(), actual.String())
+			}
+		}
+	}
+}
+
+func TestField_RowMineCnts(t *testing.T) {
+	field := buildField(
+		"*.*",
+		"...",
+	)
+
+	cnts := field.RowMineCnts()
+
+	if !reflect.DeepEqual(cnts, []int{2, 0}) {
+		t.Errorf("Unexpected counts are returned: %v.", cnts)
+	}
+}
+
+func TestField_ColumnMineCnts(t *testing.T) {
+	field := buildField(
+		"*.*",
+		"*..",
+	)
+
+	cnts := field.ColumnMineCnts()
+
+	if !reflect.DeepEqual(cnts, []int{2, 0, 1}) {
+		t.Errorf("Unexpected counts are returned: %v.", cnts)
+	}
+}
+
+func TestField_flagClosedCells(t *testing.T) {
+	field := buildField("*.*")
+	field.Cells[0][1].(*cell).state = Opened
+
+	flagged := field.flagClosedCells()
+
+	if coordsString(flagged) != "0:0 2:0 " {
+		t.Errorf("Unexpected cells are flagged: %s.", coordsString(flagged))
+	}
+
+	for _, c := range flagged {
+		if field.Cells[c.Y][c.X].State() != Flagged {
+			t.Errorf("Cell is not flagged: %d:%d.", c.X, c.Y)
+		}
+	}
+}
+
 func TestField_MarshalJSON(t *testing.T) {
 	state := Exploded
 	mine := true
@@ -633,6 +922,26 @@ func TestField_MarshalJSON(t *testing.T) {
 	}
 }
 
+func TestField_JSON_SafeStart(t *testing.T) {
+	field := buildField("*..")
+	field.SafeStart = &Coordinate{X: 2, Y: 0}
+
+	b, err := json.Marshal(field)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	restored := &Field{}
+	err = json.Unmarshal(b, restored)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !reflect.DeepEqual(restored.SafeStart, field.SafeStart) {
+		t.Errorf("Unexpected safe start is restored: %#v.", restored.SafeStart)
+	}
+}
+
 func TestField_UnmarshalJSON(t *testing.T) {
 	tests := []struct {
 		string         string
@@ -684,6 +993,39 @@ func TestField_UnmarshalJSON(t *testing.T) {
 			string:   `{"cells":[[{"has_mine":true,"state":"Dummy","surrounding_count":2}]],"height":1,"width":1}`,
 			hasError: true,
 		},
+		{
+			// Safe start is out of range
+			string:   `{"cells":[[{"has_mine":false,"state":"Closed","surrounding_count":0}]],"height":1,"width":1,"safe_start":{"x":1,"y":0}}`,
+			hasError: true,
+		},
+		{
+			// Safe start has a mine
+			string:   `{"cells":[[{"has_mine":true,"state":"Flagged","surrounding_count":2}]],"height":1,"width":1,"safe_start":{"x":0,"y":0}}`,
+			hasError: true,
+		},
+		{
+			string:   `{"cells":[[{"has_mine":false,"state":"Closed","surrounding_count":0}]],"height":1,"width":1,"safe_start":{"x":0}}`,
+			hasError: true,
+		},
+		{
+			// No row
+			string:   `{"cells":[],"height":1,"width":1}`,
+			hasError: true,
+		},
+		{
+			// Row shorter than width
+			string:   `{"cells":[[{"has_mine":true,"state":"Flagged","surrounding_count":0}]],"height":1,"width":2}`,
+			hasError: true,
+		},
+		{
+			// More rows than height
+			string:   `{"cells":[[{"has_mine":true,"state":"Flagged","surrounding_count":0}],[{"has_mine":false,"state":"Closed","surrounding_count":1}]],"height":1,"width":1}`,
+			hasError: true,
+		},
+		{
+			string:   `{"cells":[],"height":0,"width":0}`,
+			hasError: true,
+		},
 	}
 
 	for i, test := range tests {
@@ -726,3 +1068,123 @@ func TestField_UnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestField_getSurroundingCoordinates(t *testing.T) {
+	tests := []struct {
+		coord    *Coordinate
+		expected []*Coordinate
+	}{
+		{
+			coord: &Coordinate{X: 0, Y: 0},
+			expected: []*Coordinate{
+				{X: 1, Y: 0},
+				{X: 0, Y: 1},
+				{X: 1, Y: 1},
+			},
+		},
+		{
+			// Left diagonal neighbors of the second column must be included
+			coord: &Coordinate{X: 1, Y: 1},
+			expected: []*Coordinate{
+				{X: 0, Y: 0},
+				{X: 1, Y: 0},
+				{X: 2, Y: 0},
+				{X: 0, Y: 1},
+				{X: 2, Y: 1},
+				{X: 0, Y: 2},
+				{X: 1, Y: 2},
+				{X: 2, Y: 2},
+			},
+		},
+		{
+			coord: &Coordinate{X: 2, Y: 2},
+			expected: []*Coordinate{
+				{X: 1, Y: 1},
+				{X: 2, Y: 1},
+				{X: 1, Y: 2},
+			},
+		},
+	}
+
+	field := &Field{Width: 3, Height: 3}
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			coords := field.getSurroundingCoordinates(test.coord)
+
+			if len(coords) != len(test.expected) {
+				t.Fatalf("Unexpected number of coordinates is returned: %d.", len(coords))
+			}
+
+			for ii, coord := range coords {
+				if *coord != *test.expected[ii] {
+					t.Errorf("Unexpected coordinate is returned: %d:%d.", coord.X, coord.Y)
+				}
+			}
+		})
+	}
+}
+
+// buildField constructs a Field from rows of '*' for a mine and any other character for a safe cell.
+// Every cell is closed and surrounding counts are calculated from the layout.
+func buildField(rows ...string) *Field {
+	height := len(rows)
+	width := len(rows[0])
+	cells := make([][]Cell, height)
+	for y, row := range rows {
+		cells[y] = make([]Cell, width)
+		for x := range row {
+			cnt := 0
+			for dy := -1; dy <= 1; dy++ {
+				for dx := -1; dx <= 1; dx++ {
+					nx, ny := x+dx, y+dy
+					if (dx == 0 && dy == 0) || nx < 0 || ny < 0 || nx >= width || ny >= height {
+						continue
+					}
+
+					if rows[ny][nx] == '*' {
+						cnt++
+					}
+				}
+			}
+			cells[y][x] = &cell{state: Closed, mine: row[x] == '*', surroundingCnt: cnt}
+		}
+	}
+
+	return &Field{
+		Width:  width,
+		Height: height,
+		Cells:  cells,
+	}
+}
+
+func TestField_NegativeCoordinate(t *testing.T) {
+	field := &Field{
+		Width:  1,
+		Height: 1,
+		Cells: [][]Cell{
+			{
+				&cell{state: Closed},
+			},
+		},
+	}
+
+	coords := []*Coordinate{
+		{X: -1, Y: 0},
+		{X: 0, Y: -1},
+	}
+	for i, coord := range coords {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			if _, err := field.Open(coord); err != ErrCoordinateOutOfRange {
+				t.Errorf("Expected error is not returned on Open: %#v.", err)
+			}
+
+			if _, err := field.Flag(coord); err != ErrCoordinateOutOfRange {
+				t.Errorf("Expected error is not returned on Flag: %#v.", err)
+			}
+
+			if _, err := field.Unflag(coord); err != ErrCoordinateOutOfRange {
+				t.Errorf("Expected error is not returned on Unflag: %#v.", err)
+			}
+		})
+	}
+}
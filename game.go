@@ -7,11 +7,22 @@ import (
 	"github.com/tidwall/gjson"
 	"io"
 	"io/ioutil"
+	"time"
 )
 
 var (
 	// ErrOperatingFinishedGame is returned when a user tries to apply operation to a finished game.
 	ErrOperatingFinishedGame = errors.New("can not operate on finished game")
+
+	// ErrNeedsConfirmation is returned when a user tries to open a risky cell while confirmation is enabled via WithConfirmation or WithRiskConfirmation.
+	// The operation is not applied; sending the identical input again confirms and applies it.
+	ErrNeedsConfirmation = errors.New("risky operation needs confirmation")
+
+	// ErrHintBudgetExhausted is returned when a user asks for a hint but no hint is left in the budget given via WithHints.
+	ErrHintBudgetExhausted = errors.New("no hint is left")
+
+	// ErrNoHint is returned when no cell can be deduced as safe from the current field.
+	ErrNoHint = errors.New("no safe cell can be deduced")
 )
 
 // GameState depicts state of the game.
@@ -68,6 +79,24 @@ func (s GameState) String() string {
 	}
 }
 
+// String returns stringified representation of OpType.
+func (o OpType) String() string {
+	switch o {
+	case Open:
+		return "Open"
+
+	case Flag:
+		return "Flag"
+
+	case Unflag:
+		return "Unflag"
+
+	default:
+		panic(fmt.Sprintf("unknown operation type is given: %d", o))
+
+	}
+}
+
 // MarshalJSON returns GameState value that can be part of JSON structure.
 func (s GameState) MarshalJSON() ([]byte, error) {
 	return []byte(fmt.Sprintf(`"%s"`, s.String())), nil
@@ -102,6 +131,189 @@ func WithUI(ui UI) GameOption {
 	}
 }
 
+// WithLives creates GameOption that lets a user survive given number of explosions minus one.
+// A game is lost when the last life is lost; a game without this option has only one life.
+func WithLives(lives int) GameOption {
+	return func(g *Game) error {
+		if lives <= 0 {
+			return fmt.Errorf("number of lives must be positive: %d", lives)
+		}
+
+		g.lives = lives
+		return nil
+	}
+}
+
+// WithAutoFlag creates GameOption that automatically flags cells that are obviously mined after each successful open.
+// An OperatedEvent is emitted for each automatically flagged cell in the same way as WithAutoComplete.
+func WithAutoFlag() GameOption {
+	return func(g *Game) error {
+		g.autoFlag = true
+		return nil
+	}
+}
+
+// WithRules creates GameOption that feeds given Rules to judge each operation.
+// A game without this option is judged by StandardRules.
+func WithRules(rules Rules) GameOption {
+	return func(g *Game) error {
+		if err := validateRules(rules); err != nil {
+			return err
+		}
+
+		g.rules = rules
+		return nil
+	}
+}
+
+// WithMoveBudget creates GameOption for a "minesweeper golf" mode where a game is lost unless cleared within given number of opens.
+// This is a shorthand for WithRules with MoveBudgetRules; see MoveBudgetRules for details.
+func WithMoveBudget(budget int) GameOption {
+	return WithRules(&MoveBudgetRules{Budget: budget})
+}
+
+// WithAutoComplete creates GameOption that flags every remaining closed cell when a game is cleared.
+// Those cells are certainly mined once all safe cells are opened, so this saves a user from tedious endgame flagging.
+// An OperatedEvent is emitted for each automatically flagged cell before the FinishedEvent.
+func WithAutoComplete() GameOption {
+	return func(g *Game) error {
+		g.autoComplete = true
+		return nil
+	}
+}
+
+// WithMineCntHints creates GameOption that shows the total number of mines in each row and column like a nonogram,
+// which makes a logic-forward variant that is easier for newcomers.
+// This only affects the built-in UI; a UI given via WithUI may refer to Field.RowMineCnts and Field.ColumnMineCnts to do the same.
+func WithMineCntHints() GameOption {
+	return func(g *Game) error {
+		g.mineCntHints = true
+		return nil
+	}
+}
+
+// WithBlindMode creates GameOption that suppresses Game.Render and emits a FeedbackEvent on each successful operation instead.
+// This is meant for audio-only frontends, so combine this with WithEvents to receive the feedback.
+func WithBlindMode() GameOption {
+	return func(g *Game) error {
+		g.blind = true
+		return nil
+	}
+}
+
+// WithHints creates GameOption that allows a user to ask Game.Hint for a safe cell up to given number of times.
+// The number of used hints is part of the data written by Game.Save so scores can be penalized accordingly.
+func WithHints(budget int) GameOption {
+	return func(g *Game) error {
+		if budget <= 0 {
+			return fmt.Errorf("hint budget must be positive: %d", budget)
+		}
+
+		g.hintBudget = budget
+		return nil
+	}
+}
+
+// WithSandbox creates GameOption for a practice sandbox where explosions do not end the game and the built-in UI reveals mines faintly.
+// This is handy to practice patterns and to test renderers.
+// The game is judged by SandboxRules, so giving WithRules after this option overrides the rules.
+func WithSandbox() GameOption {
+	return func(g *Game) error {
+		g.sandbox = true
+		g.rules = &SandboxRules{}
+		return nil
+	}
+}
+
+// WithKidsMode creates GameOption that bundles forgiving rules for young players:
+// a 5x5 field with 3 mines, 2 lives, automatic flagging of obvious mines and a bright emoji renderer.
+// The field size given via Config is ignored when this option is applied.
+func WithKidsMode() GameOption {
+	return func(g *Game) error {
+		g.fieldConfig = &FieldConfig{
+			Width:   5,
+			Height:  5,
+			MineCnt: 3,
+		}
+		g.lives = 2
+		g.autoFlag = true
+		g.ui = &defaultUI{
+			dispCell:  dispEmoji,
+			cellWidth: 2,
+		}
+		return nil
+	}
+}
+
+// WithHighContrastUI creates GameOption that feeds a high-contrast renderer drawing each cell as a large block.
+// This is meant for low-vision players and can also be enabled by setting HighContrastEnv.
+func WithHighContrastUI(size BlockSize) GameOption {
+	return func(g *Game) error {
+		if size != Block2x1 && size != Block3x2 {
+			return fmt.Errorf("unknown block size is given: %d", size)
+		}
+
+		g.ui = newHighContrastUI(size)
+		return nil
+	}
+}
+
+// WithConfirmation creates GameOption that requires confirmation before opening a cell
+// surrounded by given number of flagged cells or more.
+// Such an open results in ErrNeedsConfirmation, and the identical input given right after that confirms the operation.
+// This prevents costly misclicks on cells that a user most likely does not intend to open.
+func WithConfirmation(flagThreshold int) GameOption {
+	return func(g *Game) error {
+		if flagThreshold <= 0 {
+			return fmt.Errorf("flag threshold must be positive: %d", flagThreshold)
+		}
+
+		g.confirmThreshold = flagThreshold
+		return nil
+	}
+}
+
+// WithRiskConfirmation creates GameOption that requires confirmation before opening a closed cell
+// whose mine probability judging from visible information is given threshold or more.
+// Confirmation works in the same way as WithConfirmation, and both options can be combined.
+// The threshold must be greater than 0 and no more than 1; see Game.MineProbability for how the risk is estimated.
+func WithRiskConfirmation(threshold float64) GameOption {
+	return func(g *Game) error {
+		if threshold <= 0 || threshold > 1 {
+			return fmt.Errorf("risk threshold must be greater than 0 and no more than 1: %f", threshold)
+		}
+
+		g.riskThreshold = threshold
+		return nil
+	}
+}
+
+// WithBoardCode creates GameOption that starts a game on the field represented by given board code,
+// which is given by Field.Code or found in a puzzle pack.
+// The field configuration given via Config is ignored when this option is applied.
+func WithBoardCode(code string) GameOption {
+	return func(g *Game) error {
+		field, err := NewFieldFromCode(code)
+		if err != nil {
+			return err
+		}
+
+		g.field = field
+		return nil
+	}
+}
+
+// WithSeed creates GameOption that places mines deterministically from given seed.
+// Field and solver operations involve no other randomness, so a game with this option is fully reproducible,
+// which helps integration tests that depend on specific mine placements.
+// Game.ID is still random unless WithIDGenerator is given.
+func WithSeed(seed int64) GameOption {
+	return func(g *Game) error {
+		g.seed = &seed
+		return nil
+	}
+}
+
 // Config contains some configuration variables for Game.
 type Config struct {
 	Field *FieldConfig `json:"field" yaml:"field"`
@@ -118,20 +330,63 @@ func NewConfig() *Config {
 // Game represents a minesweeper game.
 // Use NewGame to properly construct and start a new game.
 type Game struct {
-	field  *Field
-	ui     UI
-	state  GameState
-	quota  int
-	opened int
+	id           string
+	idGenerator  IDGenerator
+	field        *Field
+	fieldConfig  *FieldConfig
+	ui           UI
+	rules        Rules
+	state        GameState
+	quota        int
+	opened       int
+	lives        int
+	autoFlag     bool
+	autoComplete bool
+	mineCntHints bool
+	sandbox      bool
+	labeling     *Labeling
+	blind        bool
+
+	hintBudget int
+	hintsUsed  int
+	seed       *int64
+
+	confirmThreshold int
+	riskThreshold    float64
+	unconfirmed      *Coordinate
+
+	events      chan Event
+	eventPolicy EventBufferPolicy
+
+	// probabilities caches the result of Probabilities until the next open.
+	probabilities [][]float64
+
+	// moves is the number of successful operations, and opens is the number of successful open operations among them.
+	moves   int
+	opens   int
+	records [][]*CellRecord
+
+	// clock returns the current time; time.Now is used when this is nil.
+	clock func() time.Time
+
+	auditLogger AuditLogger
+
+	idleThreshold time.Duration
+	startedAt     time.Time
+	lastInputAt   time.Time
+	paused        time.Duration
+
+	// savedActiveTime is the active time carried over from a saved game.
+	savedActiveTime time.Duration
 }
 
 // NewGame is a constructor for Game.
 // Pass desired number of GameOption to alter behavior.
 func NewGame(config *Config, options ...GameOption) (*Game, error) {
 	game := &Game{
-		state:  InProgress,
-		quota:  config.Field.Width*config.Field.Height - config.Field.MineCnt,
-		opened: 0,
+		fieldConfig: config.Field,
+		state:       InProgress,
+		opened:      0,
 	}
 
 	// Apply options
@@ -142,79 +397,326 @@ func NewGame(config *Config, options ...GameOption) (*Game, error) {
 		}
 	}
 
-	// Setup field
-	field, err := NewField(config.Field)
+	// Setup field if not set via GameOption
+	if game.field == nil {
+		var field *Field
+		var err error
+		if game.seed == nil {
+			field, err = NewField(game.fieldConfig)
+		} else {
+			field, err = NewSeededField(game.fieldConfig, *game.seed)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize field: %s", err.Error())
+		}
+		game.field = field
+	}
+	for _, row := range game.field.Cells {
+		for _, c := range row {
+			if !c.hasMine() {
+				game.quota++
+			}
+		}
+	}
+
+	id, err := game.generateID()
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize field: %s", err.Error())
+		return nil, fmt.Errorf("failed to generate ID: %s", err.Error())
 	}
-	game.field = field
+	game.id = id
 
 	// Setup ui if not set via GameOption
 	if game.ui == nil {
-		game.ui = &defaultUI{}
+		game.ui = newDefaultUI()
 	}
+	game.configureUI()
 
 	return game, nil
 }
 
+// configureUI reflects settings given via GameOption to the built-in UI.
+func (g *Game) configureUI() {
+	ui, ok := g.ui.(*defaultUI)
+	if !ok {
+		return
+	}
+
+	if g.mineCntHints {
+		ui.mineCntHints = true
+	}
+
+	if g.sandbox {
+		ui.revealMines = true
+	}
+
+	if g.labeling != nil {
+		ui.labeling = g.labeling
+	}
+}
+
 // Operate receives user input and apply operation including Open, Flag and Unflag.
 //
 // Game's underlying UI is responsible for converting received input into a set of OpType and Coordinate
 // because UI presents grid and coordination in preferred format.
 func (g *Game) Operate(b []byte) (GameState, error) {
+	return g.OperateAs(nil, b)
+}
+
+// OperateAs works in the same way as Operate, and records the call with given Actor to AuditLogger given via WithAuditLogger.
+func (g *Game) OperateAs(actor *Actor, b []byte) (GameState, error) {
+	state, err := g.operateInput(b)
+	g.audit(actor, AuditOperate, b, "", err)
+	return state, err
+}
+
+// operateAs applies given operation via operate and records the call with given Actor to AuditLogger.
+// This is for callers such as Env that give an operation without raw input.
+func (g *Game) operateAs(actor *Actor, opType OpType, coord *Coordinate) (GameState, error) {
+	state, err := g.operate(opType, coord)
+	g.audit(actor, AuditOperate, nil, fmt.Sprintf("%s %d:%d", opType.String(), coord.X, coord.Y), err)
+	return state, err
+}
+
+func (g *Game) operateInput(b []byte) (GameState, error) {
 	if g.state != InProgress {
 		return g.state, ErrOperatingFinishedGame
 	}
+	g.touch()
 
 	opType, coord, err := g.ui.ParseInput(b)
 	if err != nil {
 		return g.state, fmt.Errorf("failed to parse input: %s", err.Error())
 	}
 
-	handleOpenResult := func(r *Result) {
-		if r == nil {
-			return
-		}
-
-		switch r.NewState {
-		case Exploded:
-			g.state = Lost
-
-		case Opened:
-			g.opened++
-			if g.quota == g.opened {
-				g.state = Cleared
-			}
+	return g.operate(opType, coord)
+}
 
-		default:
-			panic(fmt.Errorf("invalid operation result is returned: %s", r.NewState))
+// operate applies given operation in the same way Operate does, but without parsing user input.
+func (g *Game) operate(opType OpType, coord *Coordinate) (GameState, error) {
+	if g.state != InProgress {
+		return g.state, ErrOperatingFinishedGame
+	}
 
-		}
+	if g.needsConfirmation(opType, coord) {
+		g.unconfirmed = coord
+		return g.state, ErrNeedsConfirmation
 	}
+	g.unconfirmed = nil
+
+	var result *Result
+	var err error
 	switch opType {
 	case Open:
-		result, err := g.field.Open(coord)
-		handleOpenResult(result)
-		return g.state, err
+		result, err = g.field.Open(coord)
 
 	case Flag:
-		_, err := g.field.Flag(coord)
-		return g.state, err
+		result, err = g.field.Flag(coord)
 
 	case Unflag:
-		_, err := g.field.Unflag(coord)
-		return g.state, err
+		result, err = g.field.Unflag(coord)
 
 	default:
 		panic(fmt.Errorf("invalid OpType is returned: %d", opType))
 
 	}
+	if err != nil {
+		return g.state, err
+	}
+
+	g.emit(&OperatedEvent{
+		OpType:     opType,
+		Coordinate: coord,
+		NewState:   result.NewState,
+	})
+
+	if g.blind {
+		feedback := &FeedbackEvent{
+			OpType:        opType,
+			Coordinate:    coord,
+			NewState:      result.NewState,
+			CascadeCnt:    result.OpenedCnt,
+			NearbyFlagCnt: g.field.surroundingFlagCnt(coord),
+		}
+		if result.NewState == Opened {
+			feedback.SurroundingCnt = g.field.Cells[coord.Y][coord.X].SurroundingCnt()
+		}
+		g.emit(feedback)
+	}
+
+	if opType == Open {
+		g.probabilities = nil
+		if result.NewState == Opened {
+			g.opened += result.OpenedCnt
+		}
+
+		if g.autoComplete && g.opened == g.quota {
+			for _, c := range g.field.flagClosedCells() {
+				g.emit(&OperatedEvent{OpType: Flag, Coordinate: c, NewState: Flagged})
+			}
+		}
+	}
+
+	g.moves++
+	if opType == Open {
+		g.opens++
+	}
+	g.judge(&Situation{
+		Field:      g.field,
+		OpType:     opType,
+		Coordinate: coord,
+		Result:     result,
+		Quota:      g.quota,
+		Opened:     g.opened,
+		Lives:      g.lives,
+		Moves:      g.moves,
+		Opens:      g.opens,
+	})
+	g.record()
+
+	return g.state, nil
+}
+
+// judge applies the Verdict of underlying Rules on given Situation.
+func (g *Game) judge(s *Situation) {
+	rules := g.rules
+	if rules == nil {
+		rules = &StandardRules{}
+	}
+
+	verdict := rules.Judge(s)
+	switch verdict {
+	case Continue:
+		if g.autoFlag && s.OpType == Open && s.Result.NewState == Opened {
+			for _, c := range g.field.flagObviousMines() {
+				g.emit(&OperatedEvent{OpType: Flag, Coordinate: c, NewState: Flagged})
+			}
+		}
+
+	case LoseLife:
+		g.lives--
+		g.emit(&LifeLostEvent{Coordinate: s.Coordinate, Remaining: g.lives})
+
+	case Win:
+		g.state = Cleared
+		g.emit(&FinishedEvent{State: g.state})
+
+	case Lose:
+		g.state = Lost
+		g.emit(&FinishedEvent{State: g.state})
+
+	default:
+		panic(fmt.Errorf("invalid verdict is returned: %d", verdict))
+
+	}
+}
+
+func (g *Game) needsConfirmation(opType OpType, coord *Coordinate) bool {
+	if (g.confirmThreshold == 0 && g.riskThreshold == 0) || opType != Open {
+		return false
+	}
+
+	if g.unconfirmed != nil && *g.unconfirmed == *coord {
+		// Confirmed by identical input
+		return false
+	}
+
+	if g.confirmThreshold > 0 && g.field.surroundingFlagCnt(coord) >= g.confirmThreshold {
+		return true
+	}
+
+	if g.riskThreshold > 0 {
+		p, err := g.MineProbability(coord)
+		if err == nil && g.field.Cells[coord.Y][coord.X].State() == Closed && p >= g.riskThreshold {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Hint returns a cell that is certainly safe judging only from information visible to a user, and consumes one hint.
+// ErrHintBudgetExhausted is returned when hints are not enabled via WithHints or the budget is used up,
+// and ErrNoHint is returned without consuming a hint when no cell can be deduced.
+func (g *Game) Hint() (*Coordinate, error) {
+	return g.HintAs(nil)
+}
+
+// HintAs works in the same way as Hint, and records the call with given Actor to AuditLogger given via WithAuditLogger.
+func (g *Game) HintAs(actor *Actor) (*Coordinate, error) {
+	coord, err := g.hint()
+	g.audit(actor, AuditHint, nil, "", err)
+	return coord, err
+}
+
+func (g *Game) hint() (*Coordinate, error) {
+	if g.state != InProgress {
+		return nil, ErrOperatingFinishedGame
+	}
+
+	g.touch()
+
+	if g.hintsUsed >= g.hintBudget {
+		return nil, ErrHintBudgetExhausted
+	}
+
+	deduction := Deduce(g.field)
+	for _, coord := range deduction.Safe {
+		// Skip a cell that is wrongly flagged since it can not be opened as is
+		if g.field.Cells[coord.Y][coord.X].State() == Closed {
+			g.hintsUsed++
+			return coord, nil
+		}
+	}
+
+	return nil, ErrNoHint
+}
+
+// HintsUsed returns the number of hints given by Game.Hint.
+func (g *Game) HintsUsed() int {
+	return g.hintsUsed
+}
+
+// SafeStart returns a recommended cell to start with, which is given by a board code via WithBoardCode.
+// nil is returned when no such cell is designated.
+func (g *Game) SafeStart() *Coordinate {
+	return g.field.SafeStart
+}
+
+// MineProbability returns the probability that the cell at given Coordinate has a mine
+// judging only from information visible to a player; flags are not trusted.
+// An opened cell is 0 and an exploded cell is 1.
+func (g *Game) MineProbability(coord *Coordinate) (float64, error) {
+	if coord.X < 0 || coord.Y < 0 || coord.X+1 > g.field.Width || coord.Y+1 > g.field.Height {
+		return 0, ErrCoordinateOutOfRange
+	}
+
+	return g.Probabilities()[coord.Y][coord.X], nil
+}
+
+// Probabilities returns MineProbability of every cell indexed as [y][x].
+// The result is computed on the first call and cached until the next open, so repeated calls for GUI overlays are cheap.
+func (g *Game) Probabilities() [][]float64 {
+	if g.probabilities == nil {
+		g.probabilities = MineProbabilities(g.field)
+	}
+
+	// Return a copy so the cache is not modified by a caller
+	probabilities := make([][]float64, len(g.probabilities))
+	for i, row := range g.probabilities {
+		probabilities[i] = append([]float64(nil), row...)
+	}
+	return probabilities
 }
 
 // Render calls underlying UI's Render method to output human readable representation of this game.
 //
 // When non-nil error is returned, that indicates rendering is failed and all currently written contents must be disposed.
+// Nothing is written when blind mode is enabled via WithBlindMode.
 func (g *Game) Render(w io.Writer) error {
+	if g.blind {
+		return nil
+	}
+
 	_, err := g.ui.Render(w, g.field)
 	return err
 }
@@ -223,15 +725,27 @@ func (g *Game) Render(w io.Writer) error {
 // Written JSON can be passed to Restore to restore game.
 func (g *Game) Save(w io.Writer) (int, error) {
 	savable := struct {
-		Field  *Field    `json:"field"`
-		State  GameState `json:"state"`
-		Quota  int       `json:"quota"`
-		Opened int       `json:"opened"`
+		ID         string        `json:"id"`
+		Field      *Field        `json:"field"`
+		State      GameState     `json:"state"`
+		Quota      int           `json:"quota"`
+		Opened     int           `json:"opened"`
+		Lives      int           `json:"lives"`
+		HintsUsed  int           `json:"hints_used"`
+		Moves      int           `json:"moves"`
+		Opens      int           `json:"opens"`
+		ActiveTime time.Duration `json:"active_time"`
 	}{
-		Field:  g.field,
-		State:  g.state,
-		Quota:  g.quota,
-		Opened: g.opened,
+		ID:         g.id,
+		Field:      g.field,
+		State:      g.state,
+		Quota:      g.quota,
+		Opened:     g.opened,
+		Lives:      g.lives,
+		HintsUsed:  g.hintsUsed,
+		Moves:      g.moves,
+		Opens:      g.opens,
+		ActiveTime: g.ActiveTime(),
 	}
 
 	b, err := json.Marshal(savable)
@@ -257,8 +771,9 @@ func Restore(r io.Reader, options ...GameOption) (*Game, error) {
 
 	// Setup ui if not set via GameOption
 	if game.ui == nil {
-		game.ui = &defaultUI{}
+		game.ui = newDefaultUI()
 	}
+	game.configureUI()
 
 	// Parse saved data
 	b, err := ioutil.ReadAll(r)
@@ -267,6 +782,19 @@ func Restore(r io.Reader, options ...GameOption) (*Game, error) {
 	}
 	result := gjson.ParseBytes(b)
 
+	// Set ID
+	// This is optional to keep compatibility with data saved before IDs were introduced; a new ID is assigned in that case.
+	idValue := result.Get("id")
+	if idValue.Exists() {
+		game.id = idValue.String()
+	} else {
+		id, err := game.generateID()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate ID: %s", err.Error())
+		}
+		game.id = id
+	}
+
 	// Set state
 	stateValue := result.Get("state")
 	if !stateValue.Exists() {
@@ -292,6 +820,39 @@ func Restore(r io.Reader, options ...GameOption) (*Game, error) {
 	}
 	game.opened = int(openedValue.Int())
 
+	// Set lives
+	// This is optional to keep compatibility with data saved before lives were introduced.
+	livesValue := result.Get("lives")
+	if livesValue.Exists() {
+		game.lives = int(livesValue.Int())
+	}
+
+	// Set hints used
+	// This is optional to keep compatibility with data saved before hints were introduced.
+	hintsUsedValue := result.Get("hints_used")
+	if hintsUsedValue.Exists() {
+		game.hintsUsed = int(hintsUsedValue.Int())
+	}
+
+	// Set moves and opens
+	// These are optional to keep compatibility with data saved before moves were saved.
+	movesValue := result.Get("moves")
+	if movesValue.Exists() {
+		game.moves = int(movesValue.Int())
+	}
+
+	opensValue := result.Get("opens")
+	if opensValue.Exists() {
+		game.opens = int(opensValue.Int())
+	}
+
+	// Set active time
+	// This is optional to keep compatibility with data saved before active time was saved.
+	activeTimeValue := result.Get("active_time")
+	if activeTimeValue.Exists() {
+		game.savedActiveTime = time.Duration(activeTimeValue.Int())
+	}
+
 	// Set field
 	fieldValue := result.Get("field")
 	if !fieldValue.Exists() {
@@ -303,6 +864,12 @@ func Restore(r io.Reader, options ...GameOption) (*Game, error) {
 		return nil, fmt.Errorf("failed to construct Field: %s", err.Error())
 	}
 	game.field = field
+	game.initRestoredRecords()
+
+	// No more event is emitted for a finished game
+	if game.events != nil && game.state != InProgress {
+		close(game.events)
+	}
 
 	return game, nil
 }
@@ -77,6 +77,497 @@ func TestWithUI(t *testing.T) {
 	}
 }
 
+func TestWithLives(t *testing.T) {
+	tests := []struct {
+		lives    int
+		hasError bool
+	}{
+		{
+			lives: 3,
+		},
+		{
+			lives:    0,
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{}
+			err := WithLives(test.lives)(game)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.lives != test.lives {
+				t.Errorf("Unexpected number of lives is set: %d.", game.lives)
+			}
+		})
+	}
+}
+
+func TestWithAutoFlag(t *testing.T) {
+	game := &Game{}
+	err := WithAutoFlag()(game)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !game.autoFlag {
+		t.Error("Auto flag is not enabled.")
+	}
+}
+
+func TestWithRules(t *testing.T) {
+	game := &Game{}
+	err := WithRules(&FlagAllMinesRules{})(game)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if _, ok := game.rules.(*FlagAllMinesRules); !ok {
+		t.Errorf("Given rules are not set: %#v.", game.rules)
+	}
+
+	err = WithRules(nil)(game)
+	if err == nil {
+		t.Error("Expected error is not returned.")
+	}
+}
+
+func TestWithMoveBudget(t *testing.T) {
+	game := &Game{}
+	err := WithMoveBudget(3)(game)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	rules, ok := game.rules.(*MoveBudgetRules)
+	if !ok || rules.Budget != 3 {
+		t.Errorf("Given budget is not set: %#v.", game.rules)
+	}
+
+	err = WithMoveBudget(0)(&Game{})
+	if err == nil {
+		t.Error("Expected error is not returned.")
+	}
+}
+
+func TestGame_judge(t *testing.T) {
+	game := &Game{
+		field: buildField("*.."),
+		state: InProgress,
+		quota: 2,
+		rules: &FlagAllMinesRules{},
+	}
+
+	state, _ := game.operate(Open, &Coordinate{X: 2, Y: 0})
+	if state != InProgress {
+		t.Fatalf("Game should not be cleared before all mines are flagged: %s.", state)
+	}
+
+	state, _ = game.operate(Flag, &Coordinate{X: 0, Y: 0})
+	if state != Cleared {
+		t.Errorf("Game should be cleared: %s.", state)
+	}
+}
+
+func TestGame_judge_FlagAllMinesWithLives(t *testing.T) {
+	game := &Game{
+		field: buildField("*.*"),
+		state: InProgress,
+		quota: 1,
+		lives: 2,
+		rules: &FlagAllMinesRules{},
+	}
+
+	state, _ := game.operate(Open, &Coordinate{X: 2, Y: 0})
+	if state != InProgress {
+		t.Fatalf("Game should continue with a spare life: %s.", state)
+	}
+
+	state, _ = game.operate(Open, &Coordinate{X: 1, Y: 0})
+	if state != InProgress {
+		t.Fatalf("Game should not be cleared before all mines are flagged: %s.", state)
+	}
+
+	state, _ = game.operate(Flag, &Coordinate{X: 0, Y: 0})
+	if state != Cleared {
+		t.Errorf("Game should be cleared with the exploded mine taken as found: %s.", state)
+	}
+}
+
+func TestWithAutoComplete(t *testing.T) {
+	game := &Game{}
+	err := WithAutoComplete()(game)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !game.autoComplete {
+		t.Error("Auto complete is not enabled.")
+	}
+}
+
+func TestGame_autoComplete(t *testing.T) {
+	for i, autoComplete := range []bool{true, false} {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{
+				field:        buildField("*.."),
+				state:        InProgress,
+				quota:        2,
+				autoComplete: autoComplete,
+			}
+
+			state, err := game.operate(Open, &Coordinate{X: 2, Y: 0})
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if state != Cleared {
+				t.Fatalf("Unexpected state is returned: %s.", state)
+			}
+
+			expected := Closed
+			if autoComplete {
+				expected = Flagged
+			}
+			if game.field.Cells[0][0].State() != expected {
+				t.Errorf("Unexpected cell state: %s.", game.field.Cells[0][0].State())
+			}
+		})
+	}
+}
+
+func TestWithMineCntHints(t *testing.T) {
+	game, err := NewGame(NewConfig(), WithMineCntHints())
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	ui, ok := game.ui.(*defaultUI)
+	if !ok {
+		t.Fatalf("Unexpected UI is set: %#v.", game.ui)
+	}
+
+	if !ui.mineCntHints {
+		t.Error("Mine count hints are not enabled.")
+	}
+}
+
+func TestWithBlindMode(t *testing.T) {
+	game := &Game{}
+	err := WithBlindMode()(game)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !game.blind {
+		t.Error("Blind mode is not enabled.")
+	}
+}
+
+func TestWithHints(t *testing.T) {
+	tests := []struct {
+		budget   int
+		hasError bool
+	}{
+		{
+			budget: 3,
+		},
+		{
+			budget:   0,
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{}
+			err := WithHints(test.budget)(game)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.hintBudget != test.budget {
+				t.Errorf("Unexpected hint budget is set: %d.", game.hintBudget)
+			}
+		})
+	}
+}
+
+func TestGame_Hint(t *testing.T) {
+	field := buildField("*...")
+	field.Cells[0][1].(*cell).state = Opened
+	field.Cells[0][2].(*cell).state = Opened
+	game := &Game{
+		field:      field,
+		state:      InProgress,
+		hintBudget: 1,
+	}
+
+	coord, err := game.Hint()
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if coord.X != 3 || coord.Y != 0 {
+		t.Errorf("Unexpected hint is given: %d:%d.", coord.X, coord.Y)
+	}
+
+	if game.HintsUsed() != 1 {
+		t.Errorf("Unexpected number of used hints: %d.", game.HintsUsed())
+	}
+
+	_, err = game.Hint()
+	if err != ErrHintBudgetExhausted {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+
+	noHint := &Game{
+		field:      buildField("*..."),
+		state:      InProgress,
+		hintBudget: 1,
+	}
+	_, err = noHint.Hint()
+	if err != ErrNoHint {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+	if noHint.HintsUsed() != 0 {
+		t.Error("Hint must not be consumed when nothing is deduced.")
+	}
+
+	finished := &Game{state: Cleared, hintBudget: 1}
+	_, err = finished.Hint()
+	if err != ErrOperatingFinishedGame {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
+
+func TestWithSandbox(t *testing.T) {
+	game, err := NewGame(NewConfig(), WithSandbox())
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if _, ok := game.rules.(*SandboxRules); !ok {
+		t.Errorf("Unexpected rules are set: %#v.", game.rules)
+	}
+
+	ui, ok := game.ui.(*defaultUI)
+	if !ok {
+		t.Fatalf("Unexpected UI is set: %#v.", game.ui)
+	}
+
+	if !ui.revealMines {
+		t.Error("Mines are not revealed.")
+	}
+}
+
+func TestWithKidsMode(t *testing.T) {
+	game, err := NewGame(NewConfig(), WithKidsMode())
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if game.field.Width != 5 || game.field.Height != 5 {
+		t.Errorf("Unexpected field size: %dx%d.", game.field.Width, game.field.Height)
+	}
+
+	if game.quota != 5*5-3 {
+		t.Errorf("Unexpected quota value is set: %d.", game.quota)
+	}
+
+	if game.lives != 2 {
+		t.Errorf("Unexpected number of lives is set: %d.", game.lives)
+	}
+
+	if !game.autoFlag {
+		t.Error("Auto flag is not enabled.")
+	}
+
+	ui, ok := game.ui.(*defaultUI)
+	if !ok || ui.dispCell == nil {
+		t.Errorf("Emoji UI is not set: %#v.", game.ui)
+	}
+}
+
+func TestWithHighContrastUI(t *testing.T) {
+	tests := []struct {
+		size     BlockSize
+		hasError bool
+	}{
+		{
+			size: Block2x1,
+		},
+		{
+			size: Block3x2,
+		},
+		{
+			size:     123,
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{}
+			err := WithHighContrastUI(test.size)(game)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.ui == nil {
+				t.Error("UI is not set.")
+			}
+		})
+	}
+}
+
+func TestWithConfirmation(t *testing.T) {
+	tests := []struct {
+		threshold int
+		hasError  bool
+	}{
+		{
+			threshold: 2,
+		},
+		{
+			threshold: 0,
+			hasError:  true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{}
+			err := WithConfirmation(test.threshold)(game)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.confirmThreshold != test.threshold {
+				t.Errorf("Unexpected threshold is set: %d.", game.confirmThreshold)
+			}
+		})
+	}
+}
+
+func TestWithBoardCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		hasError bool
+	}{
+		{
+			code: "3x3-hAA",
+		},
+		{
+			code: "3x3-hAA@1,1",
+		},
+		{
+			code:     "invalid",
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game, err := NewGame(NewConfig(), WithBoardCode(test.code))
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.field.Code() != test.code {
+				t.Errorf("Unexpected field is set: %s.", game.field.Code())
+			}
+
+			if game.quota != 7 {
+				t.Errorf("Unexpected quota value is set: %d.", game.quota)
+			}
+
+			if game.SafeStart() != game.field.SafeStart {
+				t.Errorf("Unexpected safe start is returned: %#v.", game.SafeStart())
+			}
+		})
+	}
+}
+
+func TestWithSeed(t *testing.T) {
+	config := &Config{Field: &FieldConfig{Width: 9, Height: 9, MineCnt: 10}}
+
+	game1, err := NewGame(config, WithSeed(42))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	game2, err := NewGame(config, WithSeed(42))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if game1.field.Code() != game2.field.Code() {
+		t.Errorf("Fields differ with the same seed: %s and %s.", game1.field.Code(), game2.field.Code())
+	}
+
+	game3, _ := NewGame(config, WithSeed(43))
+	if game1.field.Code() == game3.field.Code() {
+		t.Errorf("Fields are identical with different seeds: %s.", game1.field.Code())
+	}
+}
+
 func TestNewConfig(t *testing.T) {
 	config := NewConfig()
 
@@ -323,6 +814,296 @@ func TestGame_Operate(t *testing.T) {
 	}
 }
 
+func TestGame_Operate_Lives(t *testing.T) {
+	field := &Field{
+		Width:  3,
+		Height: 1,
+		Cells: [][]Cell{
+			{
+				&cell{state: Closed, mine: true, surroundingCnt: 0},
+				&cell{state: Closed, mine: false, surroundingCnt: 2},
+				&cell{state: Closed, mine: true, surroundingCnt: 0},
+			},
+		},
+	}
+	coords := []*Coordinate{{X: 0, Y: 0}, {X: 2, Y: 0}}
+	game := &Game{
+		ui: &DummyUI{
+			ParseInputFunc: func(_ []byte) (OpType, *Coordinate, error) {
+				coord := coords[0]
+				coords = coords[1:]
+				return Open, coord, nil
+			},
+		},
+		field: field,
+		state: InProgress,
+		quota: 1,
+		lives: 2,
+	}
+
+	state, err := game.Operate([]byte("dummy"))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if state != InProgress {
+		t.Errorf("Game should continue while a life remains: %s.", state.String())
+	}
+
+	if game.lives != 1 {
+		t.Errorf("Unexpected number of lives remains: %d.", game.lives)
+	}
+
+	state, err = game.Operate([]byte("dummy"))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if state != Lost {
+		t.Errorf("Game should be lost when the last life is lost: %s.", state.String())
+	}
+}
+
+func TestGame_Operate_AutoFlag(t *testing.T) {
+	field := &Field{
+		Width:  5,
+		Height: 1,
+		Cells: [][]Cell{
+			{
+				&cell{state: Closed, mine: false, surroundingCnt: 1},
+				&cell{state: Closed, mine: true, surroundingCnt: 0},
+				&cell{state: Closed, mine: false, surroundingCnt: 1},
+				&cell{state: Closed, mine: false, surroundingCnt: 0},
+				&cell{state: Closed, mine: false, surroundingCnt: 0},
+			},
+		},
+	}
+	game := &Game{
+		ui: &DummyUI{
+			ParseInputFunc: func(_ []byte) (OpType, *Coordinate, error) {
+				return Open, &Coordinate{X: 4, Y: 0}, nil
+			},
+		},
+		field:    field,
+		state:    InProgress,
+		quota:    4,
+		autoFlag: true,
+		events:   make(chan Event, 10),
+	}
+
+	_, err := game.Operate([]byte("dummy"))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if field.Cells[0][1].State() != Flagged {
+		t.Errorf("Obvious mine is not flagged: %s.", field.Cells[0][1].State())
+	}
+
+	<-game.events // OperatedEvent of the open
+	select {
+	case e := <-game.events:
+		operated, ok := e.(*OperatedEvent)
+		if !ok || operated.OpType != Flag || *operated.Coordinate != (Coordinate{X: 1, Y: 0}) {
+			t.Errorf("Unexpected event is emitted: %#v.", e)
+		}
+
+	default:
+		t.Error("OperatedEvent is not emitted for the automatically flagged cell.")
+
+	}
+
+	if field.Cells[0][0].State() != Closed {
+		t.Errorf("Safe cell must not be flagged: %s.", field.Cells[0][0].State())
+	}
+}
+
+func TestGame_Operate_Confirmation(t *testing.T) {
+	field := &Field{
+		Width:  3,
+		Height: 1,
+		Cells: [][]Cell{
+			{
+				&cell{state: Flagged, mine: true, surroundingCnt: 0},
+				&cell{state: Closed, mine: false, surroundingCnt: 1},
+				&cell{state: Closed, mine: false, surroundingCnt: 0},
+			},
+		},
+	}
+	inputs := []struct {
+		opType OpType
+		coord  *Coordinate
+		err    error
+	}{
+		{opType: Open, coord: &Coordinate{X: 1, Y: 0}, err: ErrNeedsConfirmation},
+		{opType: Flag, coord: &Coordinate{X: 2, Y: 0}},
+		{opType: Open, coord: &Coordinate{X: 1, Y: 0}, err: ErrNeedsConfirmation},
+		{opType: Open, coord: &Coordinate{X: 1, Y: 0}},
+	}
+	i := 0
+	game := &Game{
+		ui: &DummyUI{
+			ParseInputFunc: func(_ []byte) (OpType, *Coordinate, error) {
+				input := inputs[i]
+				return input.opType, input.coord, nil
+			},
+		},
+		field:            field,
+		state:            InProgress,
+		quota:            2,
+		confirmThreshold: 1,
+	}
+
+	for ; i < len(inputs); i++ {
+		_, err := game.Operate([]byte("dummy"))
+		if err != inputs[i].err {
+			t.Fatalf("Unexpected error is returned on input #%d: %v.", i+1, err)
+		}
+	}
+
+	if field.Cells[0][1].State() != Opened {
+		t.Errorf("Confirmed cell is not opened: %s.", field.Cells[0][1].State())
+	}
+}
+
+func TestWithRiskConfirmation(t *testing.T) {
+	tests := []struct {
+		threshold float64
+		hasError  bool
+	}{
+		{
+			threshold: 0.5,
+		},
+		{
+			threshold: 1,
+		},
+		{
+			threshold: 0,
+			hasError:  true,
+		},
+		{
+			threshold: 1.5,
+			hasError:  true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{}
+			err := WithRiskConfirmation(test.threshold)(game)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.riskThreshold != test.threshold {
+				t.Errorf("Unexpected threshold is set: %f.", game.riskThreshold)
+			}
+		})
+	}
+}
+
+func TestGame_Operate_RiskConfirmation(t *testing.T) {
+	tests := []struct {
+		threshold float64
+		confirm   bool
+	}{
+		{
+			// Every cell has a mine by 0.25
+			threshold: 0.25,
+			confirm:   true,
+		},
+		{
+			threshold: 0.3,
+			confirm:   false,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			field := buildField("*...")
+			game := &Game{
+				field:         field,
+				state:         InProgress,
+				quota:         3,
+				riskThreshold: test.threshold,
+			}
+
+			coord := &Coordinate{X: 3, Y: 0}
+			_, err := game.operate(Open, coord)
+			if test.confirm {
+				if err != ErrNeedsConfirmation {
+					t.Fatalf("Expected error is not returned: %v.", err)
+				}
+
+				_, err = game.operate(Open, coord)
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if field.Cells[0][3].State() != Opened {
+				t.Errorf("Cell is not opened: %s.", field.Cells[0][3].State())
+			}
+		})
+	}
+}
+
+func TestGame_MineProbability(t *testing.T) {
+	game := &Game{
+		field: buildField("*..."),
+		state: InProgress,
+		quota: 3,
+	}
+
+	p, err := game.MineProbability(&Coordinate{X: 0, Y: 0})
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+	if p != 0.25 {
+		t.Errorf("Unexpected probability is returned: %f.", p)
+	}
+
+	// Cached probabilities must be discarded on open
+	_, err = game.operate(Open, &Coordinate{X: 3, Y: 0})
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	p, _ = game.MineProbability(&Coordinate{X: 0, Y: 0})
+	if p != 1 {
+		t.Errorf("Unexpected probability is returned after open: %f.", p)
+	}
+
+	_, err = game.MineProbability(&Coordinate{X: 4, Y: 0})
+	if err != ErrCoordinateOutOfRange {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
+
+func TestGame_Probabilities(t *testing.T) {
+	game := &Game{
+		field: buildField("*..."),
+		state: InProgress,
+	}
+
+	probabilities := game.Probabilities()
+	probabilities[0][0] = 0
+
+	if game.Probabilities()[0][0] != 0.25 {
+		t.Error("Cached probabilities are modified via returned value.")
+	}
+}
+
 func TestGame_Render(t *testing.T) {
 	str := "dummy"
 	ui := &DummyUI{
@@ -346,6 +1127,18 @@ func TestGame_Render(t *testing.T) {
 	if output != str {
 		t.Errorf("Unexpected output is given: %s.", output)
 	}
+
+	game.blind = true
+	w.Reset()
+	err = game.Render(w)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if w.Len() != 0 {
+		t.Errorf("Nothing should be rendered in blind mode: %s.", w.String())
+	}
 }
 
 func TestGame_Save(t *testing.T) {
@@ -382,7 +1175,7 @@ func TestGame_Save(t *testing.T) {
 
 	// {"field":{"cells":[[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":false,"state":"Closed","surrounding_count":1}],[{"has_mine":true,"state":"Closed","surrounding_count":0},{"has_mine":false,"state":"Closed","surrounding_count":1}]],"height":2,"width":2},"state":"InProgress","quota":1,"opened":1}
 	str := buf.String()
-	for _, jsonField := range []string{"field", "state", "quota", "opened"} {
+	for _, jsonField := range []string{"id", "field", "state", "quota", "opened", "lives", "hints_used", "moves", "opens", "active_time"} {
 		if !strings.Contains(str, jsonField) {
 			t.Errorf(`Mandatory field "%s" is not present`, jsonField)
 		}
@@ -391,12 +1184,16 @@ func TestGame_Save(t *testing.T) {
 
 func TestRestore(t *testing.T) {
 	tests := []struct {
-		str      string
-		options  []GameOption
-		hasError bool
-		state    GameState
-		quota    int
-		opened   int
+		str       string
+		options   []GameOption
+		hasError  bool
+		state     GameState
+		quota     int
+		opened    int
+		lives     int
+		hintsUsed int
+		moves     int
+		opens     int
 	}{
 		{
 			str:    `{"state":"InProgress","quota":1,"opened":2,"field":{"cells":[[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":false,"state":"Opened","surrounding_count":1}],[{"has_mine":true,"state":"Closed","surrounding_count":0},{"has_mine":false,"state":"Closed","surrounding_count":1}]],"height":2,"width":2}}`,
@@ -404,6 +1201,28 @@ func TestRestore(t *testing.T) {
 			quota:  1,
 			opened: 2,
 		},
+		{
+			str:    `{"state":"InProgress","quota":1,"opened":2,"lives":2,"field":{"cells":[[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":false,"state":"Opened","surrounding_count":1}],[{"has_mine":true,"state":"Closed","surrounding_count":0},{"has_mine":false,"state":"Closed","surrounding_count":1}]],"height":2,"width":2}}`,
+			state:  InProgress,
+			quota:  1,
+			opened: 2,
+			lives:  2,
+		},
+		{
+			str:       `{"state":"InProgress","quota":1,"opened":2,"hints_used":3,"field":{"cells":[[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":false,"state":"Opened","surrounding_count":1}],[{"has_mine":true,"state":"Closed","surrounding_count":0},{"has_mine":false,"state":"Closed","surrounding_count":1}]],"height":2,"width":2}}`,
+			state:     InProgress,
+			quota:     1,
+			opened:    2,
+			hintsUsed: 3,
+		},
+		{
+			str:    `{"state":"InProgress","quota":1,"opened":2,"moves":5,"opens":3,"field":{"cells":[[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":false,"state":"Opened","surrounding_count":1}],[{"has_mine":true,"state":"Closed","surrounding_count":0},{"has_mine":false,"state":"Closed","surrounding_count":1}]],"height":2,"width":2}}`,
+			state:  InProgress,
+			quota:  1,
+			opened: 2,
+			moves:  5,
+			opens:  3,
+		},
 		{
 			str:      `{"state":"INVALID_STATE","quota":1,"opened":2,"field":{"cells":[[{"has_mine":false,"state":"Opened","surrounding_count":1},{"has_mine":false,"state":"Opened","surrounding_count":1}],[{"has_mine":true,"state":"Closed","surrounding_count":0},{"has_mine":false,"state":"Closed","surrounding_count":1}]],"height":2,"width":2}}`,
 			hasError: true,
@@ -465,6 +1284,59 @@ func TestRestore(t *testing.T) {
 			if game.opened != test.opened {
 				t.Errorf("Unexpected opened is set: %d.", game.opened)
 			}
+
+			if game.lives != test.lives {
+				t.Errorf("Unexpected lives is set: %d.", game.lives)
+			}
+
+			if game.hintsUsed != test.hintsUsed {
+				t.Errorf("Unexpected hints used is set: %d.", game.hintsUsed)
+			}
+
+			if game.moves != test.moves || game.opens != test.opens {
+				t.Errorf("Unexpected moves are set: %d and %d.", game.moves, game.opens)
+			}
+		})
+	}
+}
+
+func TestOpType_String(t *testing.T) {
+	tests := []struct {
+		opType OpType
+		str    string
+	}{
+		{
+			opType: Open,
+			str:    "Open",
+		},
+		{
+			opType: Flag,
+			str:    "Flag",
+		},
+		{
+			opType: Unflag,
+			str:    "Unflag",
+		},
+		{
+			opType: 999,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if test.str != "" {
+						t.Fatal("Panicked unexpectedly.")
+					}
+				}
+			}()
+
+			str := test.opType.String()
+
+			if str != test.str {
+				t.Errorf("Unexpected value is returned: %s.", str)
+			}
 		})
 	}
 }
@@ -0,0 +1,39 @@
+package minesweeper
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// IDGenerator returns a new identifier for a game.
+// Pass one via WithIDGenerator to use IDs that fit the hosting infrastructure such as UUIDv7, ULID or snowflake IDs.
+type IDGenerator func() (string, error)
+
+// WithIDGenerator creates GameOption that feeds given IDGenerator to assign Game.ID on construction.
+// A game without this option is assigned a random 128-bit ID in hexadecimal even when a seed is given via WithSeed,
+// so games built from the same seed such as a daily puzzle are still told apart. Pass a deterministic IDGenerator for byte-identical saves.
+func WithIDGenerator(generator IDGenerator) GameOption {
+	return func(g *Game) error {
+		g.idGenerator = generator
+		return nil
+	}
+}
+
+// ID returns the identifier of this game.
+// The ID is kept across Save and Restore.
+func (g *Game) ID() string {
+	return g.id
+}
+
+func (g *Game) generateID() (string, error) {
+	if g.idGenerator != nil {
+		return g.idGenerator()
+	}
+
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
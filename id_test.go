@@ -0,0 +1,81 @@
+package minesweeper
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestWithIDGenerator(t *testing.T) {
+	game, err := NewGame(NewConfig(), WithIDGenerator(func() (string, error) {
+		return "game-1", nil
+	}))
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if game.ID() != "game-1" {
+		t.Errorf("Unexpected ID is assigned: %s.", game.ID())
+	}
+
+	_, err = NewGame(NewConfig(), WithIDGenerator(func() (string, error) {
+		return "", errors.New("dummy")
+	}))
+	if err == nil {
+		t.Error("Expected error is not returned.")
+	}
+}
+
+func TestGame_ID(t *testing.T) {
+	game1, _ := NewGame(NewConfig())
+	game2, _ := NewGame(NewConfig())
+
+	if len(game1.ID()) != 32 {
+		t.Errorf("Unexpected ID is assigned: %s.", game1.ID())
+	}
+
+	if game1.ID() == game2.ID() {
+		t.Errorf("IDs must differ: %s.", game1.ID())
+	}
+
+	saved := bytes.NewBuffer([]byte{})
+	_, err := game1.Save(saved)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	restored, err := Restore(saved)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if restored.ID() != game1.ID() {
+		t.Errorf("ID is not restored: %s.", restored.ID())
+	}
+}
+
+func TestGame_ID_Seeded(t *testing.T) {
+	config := &Config{Field: &FieldConfig{Width: 9, Height: 9, MineCnt: 10}}
+	game1, _ := NewGame(config, WithSeed(42))
+	game2, _ := NewGame(config, WithSeed(42))
+
+	// Games built from the same seed are still told apart
+	if game1.ID() == game2.ID() {
+		t.Errorf("IDs must differ: %s.", game1.ID())
+	}
+
+	generator := func() (string, error) {
+		return "daily", nil
+	}
+	game1, _ = NewGame(config, WithSeed(42), WithIDGenerator(generator))
+	game2, _ = NewGame(config, WithSeed(42), WithIDGenerator(generator))
+
+	saved1 := bytes.NewBuffer([]byte{})
+	saved2 := bytes.NewBuffer([]byte{})
+	_, _ = game1.Save(saved1)
+	_, _ = game2.Save(saved2)
+	if saved1.String() != saved2.String() {
+		t.Errorf("Saved data must be the same for the same seed and ID: %s.", saved1.String())
+	}
+}
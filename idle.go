@@ -0,0 +1,89 @@
+package minesweeper
+
+import (
+	"fmt"
+	"time"
+)
+
+// PausedEvent is emitted when a game is found paused by WithIdlePause.
+// This is emitted on the next input right before ResumedEvent since Game has no goroutine of its own to detect the pause as it happens;
+// use Game.Paused to check if the game is paused in the meantime.
+type PausedEvent struct {
+	// PausedAt is when the game was paused, which is the threshold past the last input.
+	PausedAt time.Time
+}
+
+func (*PausedEvent) event() {}
+
+// ResumedEvent is emitted when a user gives input to a game that is paused by WithIdlePause.
+type ResumedEvent struct {
+	// PausedAt is when the game was paused, which is the threshold past the last input.
+	PausedAt time.Time
+
+	// Paused is how long the game was paused.
+	Paused time.Duration
+}
+
+func (*ResumedEvent) event() {}
+
+// WithIdlePause creates GameOption that pauses a game when no input is given for given duration, and resumes on the next input.
+// The paused time is excluded from Game.ActiveTime so speedrun times are not affected by breaks.
+// Operating, hinting and adjusting a game, as well as stepping an Env, are all taken as input.
+// Since Game has no goroutine of its own, the pause is detected on the next input and reported by PausedEvent and ResumedEvent.
+func WithIdlePause(threshold time.Duration) GameOption {
+	return func(g *Game) error {
+		if threshold <= 0 {
+			return fmt.Errorf("idle threshold must be positive: %s", threshold)
+		}
+
+		g.idleThreshold = threshold
+		return nil
+	}
+}
+
+// ActiveTime returns the time elapsed from the first input to the last input, excluding paused time.
+// Active time is written by Game.Save, and a restored game continues counting from there.
+// Zero is returned unless WithIdlePause is given.
+func (g *Game) ActiveTime() time.Duration {
+	if g.startedAt.IsZero() {
+		return g.savedActiveTime
+	}
+	return g.savedActiveTime + g.lastInputAt.Sub(g.startedAt) - g.paused
+}
+
+// Paused tells if the game is currently paused by WithIdlePause, that is, no input is given for longer than the threshold.
+// Time before the first input is not measured, so false is returned until then, as well as when WithIdlePause is not given.
+func (g *Game) Paused() bool {
+	if g.idleThreshold == 0 || g.lastInputAt.IsZero() {
+		return false
+	}
+	return g.now().Sub(g.lastInputAt) > g.idleThreshold
+}
+
+// touch records an input and resumes the game when it has been idle longer than the threshold.
+func (g *Game) touch() {
+	if g.idleThreshold == 0 {
+		return
+	}
+
+	now := g.now()
+	if g.startedAt.IsZero() {
+		g.startedAt = now
+		g.lastInputAt = now
+		return
+	}
+
+	if idle := now.Sub(g.lastInputAt); idle > g.idleThreshold {
+		pausedAt := g.lastInputAt.Add(g.idleThreshold)
+		paused := idle - g.idleThreshold
+		g.paused += paused
+		g.emit(&PausedEvent{
+			PausedAt: pausedAt,
+		})
+		g.emit(&ResumedEvent{
+			PausedAt: pausedAt,
+			Paused:   paused,
+		})
+	}
+	g.lastInputAt = now
+}
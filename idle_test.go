@@ -0,0 +1,233 @@
+package minesweeper
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWithIdlePause(t *testing.T) {
+	tests := []struct {
+		threshold time.Duration
+		hasError  bool
+	}{
+		{
+			threshold: time.Minute,
+		},
+		{
+			threshold: 0,
+			hasError:  true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			game := &Game{}
+			err := WithIdlePause(test.threshold)(game)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if game.idleThreshold != test.threshold {
+				t.Errorf("Unexpected threshold is set: %s.", game.idleThreshold)
+			}
+		})
+	}
+}
+
+func TestGame_ActiveTime(t *testing.T) {
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := start
+	game := &Game{
+		field: buildField("*...."),
+		state: InProgress,
+		quota: 4,
+		ui: &DummyUI{
+			ParseInputFunc: func(b []byte) (OpType, *Coordinate, error) {
+				return Flag, &Coordinate{X: 0, Y: 0}, nil
+			},
+		},
+		clock:         func() time.Time { return now },
+		idleThreshold: 10 * time.Second,
+		events:        make(chan Event, 10),
+		eventPolicy:   BlockOnFull,
+	}
+	game.touch()
+
+	// Active
+	now = now.Add(5 * time.Second)
+	_, _ = game.Operate([]byte("dummy"))
+
+	// Idle for 30 seconds, so paused for 20 seconds
+	now = now.Add(30 * time.Second)
+	_, _ = game.Operate([]byte("dummy"))
+
+	if game.ActiveTime() != 15*time.Second {
+		t.Errorf("Unexpected active time is returned: %s.", game.ActiveTime())
+	}
+
+	var paused *PausedEvent
+	var resumed *ResumedEvent
+	for len(game.events) > 0 {
+		switch e := (<-game.events).(type) {
+		case *PausedEvent:
+			paused = e
+
+		case *ResumedEvent:
+			if paused == nil {
+				t.Error("ResumedEvent is emitted before PausedEvent.")
+			}
+			resumed = e
+
+		}
+	}
+
+	if paused == nil || resumed == nil {
+		t.Fatal("PausedEvent or ResumedEvent is not emitted.")
+	}
+
+	if !paused.PausedAt.Equal(start.Add(15 * time.Second)) {
+		t.Errorf("Unexpected event is emitted: %#v.", paused)
+	}
+
+	if !resumed.PausedAt.Equal(start.Add(15*time.Second)) || resumed.Paused != 20*time.Second {
+		t.Errorf("Unexpected event is emitted: %#v.", resumed)
+	}
+
+	if (&Game{}).ActiveTime() != 0 {
+		t.Error("Active time must be zero without idle pause.")
+	}
+}
+
+func TestGame_Paused(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	game := &Game{
+		field:         buildField("*...."),
+		state:         InProgress,
+		clock:         func() time.Time { return now },
+		idleThreshold: 10 * time.Second,
+	}
+
+	if game.Paused() {
+		t.Error("Game must not be paused before any input.")
+	}
+
+	game.touch()
+	now = now.Add(10 * time.Second)
+	if game.Paused() {
+		t.Error("Game must not be paused within the threshold.")
+	}
+
+	now = now.Add(time.Second)
+	if !game.Paused() {
+		t.Error("Game must be paused past the threshold.")
+	}
+
+	_, _ = game.Hint()
+	if game.Paused() {
+		t.Error("Game must be resumed by a hint.")
+	}
+
+	now = now.Add(time.Minute)
+	_ = game.Adjust(AdjustAutoFlag(true))
+	if game.Paused() {
+		t.Error("Game must be resumed by an adjustment.")
+	}
+
+	// Up to the threshold of each idle period is counted as active
+	if game.ActiveTime() != 20*time.Second {
+		t.Errorf("Unexpected active time is returned: %s.", game.ActiveTime())
+	}
+
+	if (&Game{}).Paused() {
+		t.Error("Game must not be paused without idle pause.")
+	}
+}
+
+func TestGame_ActiveTime_Restore(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+	game, err := NewGame(NewConfig(), WithClock(clock), WithIdlePause(time.Minute))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	game.touch()
+	now = now.Add(30 * time.Second)
+	game.touch()
+
+	saved := bytes.NewBuffer([]byte{})
+	_, err = game.Save(saved)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	// Time between save and restore is not counted
+	now = now.Add(time.Hour)
+	restored, err := Restore(saved, WithClock(clock), WithIdlePause(time.Minute))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if restored.ActiveTime() != 30*time.Second {
+		t.Errorf("Active time is not restored: %s.", restored.ActiveTime())
+	}
+
+	restored.touch()
+	now = now.Add(20 * time.Second)
+	restored.touch()
+
+	if restored.ActiveTime() != 50*time.Second {
+		t.Errorf("Unexpected active time is returned: %s.", restored.ActiveTime())
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	game := &Game{}
+	err := WithClock(func() time.Time { return now })(game)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !game.now().Equal(now) {
+		t.Errorf("Given clock is not used: %s.", game.now())
+	}
+
+	err = WithClock(nil)(game)
+	if err == nil {
+		t.Error("Expected error is not returned.")
+	}
+}
+
+func TestGame_ActiveTime_FirstInput(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	game, err := NewGame(NewConfig(), WithClock(func() time.Time { return now }), WithIdlePause(time.Minute))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	// Left untouched before the first move
+	now = now.Add(time.Hour)
+	if game.Paused() {
+		t.Error("Game must not be paused before the first input.")
+	}
+
+	_ = game.Adjust(AdjustAutoFlag(true))
+	now = now.Add(30 * time.Second)
+	_ = game.Adjust(AdjustAutoFlag(false))
+
+	if game.ActiveTime() != 30*time.Second {
+		t.Errorf("Active time must be counted from the first input: %s.", game.ActiveTime())
+	}
+}
@@ -0,0 +1,102 @@
+package minesweeper
+
+import (
+	"errors"
+	"math"
+	"strconv"
+)
+
+// Labeling defines how the built-in UI labels coordinates.
+// The same labels are used to render a field and to parse user input so both always share the same notation.
+type Labeling struct {
+	// LettersOnX labels the x-axis with letters and the y-axis with numbers like chess notation.
+	// By default, the x-axis is labeled with numbers and the y-axis with letters.
+	LettersOnX bool `json:"letters_on_x" yaml:"letters_on_x"`
+
+	// ZeroBased starts numeric labels from 0 instead of 1.
+	ZeroBased bool `json:"zero_based" yaml:"zero_based"`
+}
+
+// NewLabeling construct Labeling with default values.
+// Use json.Unmarshal, yaml.Unmarshal or manual manipulation to override default values.
+func NewLabeling() *Labeling {
+	return &Labeling{
+		LettersOnX: false,
+		ZeroBased:  false,
+	}
+}
+
+// Label returns labels of given Coordinate in the form a user types as input such as "3 b".
+// An empty string is returned for a negative coordinate, which has no label.
+func (l *Labeling) Label(coord *Coordinate) string {
+	if coord.X < 0 || coord.Y < 0 {
+		return ""
+	}
+	return l.xLabels(coord.X + 1)[coord.X] + " " + l.yLabels(coord.Y + 1)[coord.Y]
+}
+
+func (l *Labeling) xLabels(width int) []string {
+	if l.LettersOnX {
+		return letterLabels(width)
+	}
+	return l.numberLabels(width)
+}
+
+func (l *Labeling) yLabels(height int) []string {
+	if l.LettersOnX {
+		return l.numberLabels(height)
+	}
+	return letterLabels(height)
+}
+
+func (l *Labeling) numberLabels(n int) []string {
+	offset := 1
+	if l.ZeroBased {
+		offset = 0
+	}
+
+	labels := make([]string, n)
+	for i := range labels {
+		labels[i] = strconv.Itoa(i + offset)
+	}
+	return labels
+}
+
+// letterLabels returns labels of [a, b, c, ...., z, aa, ab, ...].
+func letterLabels(n int) []string {
+	labels := make([]string, n)
+	candidates := [...]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v", "w", "x", "y", "z"}
+	candidatesN := len(candidates)
+
+	for i := 0; i < n; i++ {
+		m := i + 1
+		for m > 0 {
+			m -= 1
+			labels[i] = candidates[m%candidatesN] + labels[i]
+			m = int(math.Floor(float64(m) / float64(candidatesN)))
+		}
+	}
+	return labels
+}
+
+// WithLabeling creates GameOption that feeds given Labeling to the built-in UI.
+// This has no effect on a UI given via WithUI.
+func WithLabeling(labeling *Labeling) GameOption {
+	return func(g *Game) error {
+		if labeling == nil {
+			return errors.New("labeling must not be nil")
+		}
+
+		g.labeling = labeling
+		return nil
+	}
+}
+
+// Label returns labels of given Coordinate in the notation of this game,
+// so hints and messages can refer to a cell in the same way the built-in UI renders and parses it.
+func (g *Game) Label(coord *Coordinate) string {
+	if g.labeling == nil {
+		return NewLabeling().Label(coord)
+	}
+	return g.labeling.Label(coord)
+}
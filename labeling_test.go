@@ -0,0 +1,129 @@
+package minesweeper
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestNewLabeling(t *testing.T) {
+	labeling := NewLabeling()
+
+	if labeling.LettersOnX || labeling.ZeroBased {
+		t.Errorf("Unexpected default values are set: %#v.", labeling)
+	}
+}
+
+func TestLabeling_Label(t *testing.T) {
+	tests := []struct {
+		labeling *Labeling
+		coord    *Coordinate
+		expected string
+	}{
+		{
+			labeling: &Labeling{},
+			coord:    &Coordinate{X: 2, Y: 27},
+			expected: "3 ab",
+		},
+		{
+			labeling: &Labeling{LettersOnX: true},
+			coord:    &Coordinate{X: 2, Y: 27},
+			expected: "c 28",
+		},
+		{
+			labeling: &Labeling{ZeroBased: true},
+			coord:    &Coordinate{X: 2, Y: 0},
+			expected: "2 a",
+		},
+		{
+			labeling: &Labeling{LettersOnX: true, ZeroBased: true},
+			coord:    &Coordinate{X: 0, Y: 0},
+			expected: "a 0",
+		},
+		{
+			labeling: &Labeling{},
+			coord:    &Coordinate{X: -1, Y: 0},
+			expected: "",
+		},
+		{
+			labeling: &Labeling{},
+			coord:    &Coordinate{X: 0, Y: -1},
+			expected: "",
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			label := test.labeling.Label(test.coord)
+
+			if label != test.expected {
+				t.Errorf("Unexpected label is returned: %s.", label)
+			}
+		})
+	}
+}
+
+func TestWithLabeling(t *testing.T) {
+	labeling := &Labeling{LettersOnX: true}
+	game, err := NewGame(NewConfig(), WithLabeling(labeling))
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	ui, ok := game.ui.(*defaultUI)
+	if !ok {
+		t.Fatalf("Unexpected UI is set: %#v.", game.ui)
+	}
+
+	if ui.labeling != labeling {
+		t.Errorf("Given labeling is not set: %#v.", ui.labeling)
+	}
+
+	if game.Label(&Coordinate{X: 1, Y: 1}) != "b 2" {
+		t.Errorf("Unexpected label is returned: %s.", game.Label(&Coordinate{X: 1, Y: 1}))
+	}
+
+	_, err = NewGame(NewConfig(), WithLabeling(nil))
+	if err == nil {
+		t.Error("Expected error is not returned.")
+	}
+}
+
+func TestGame_Label(t *testing.T) {
+	game := &Game{}
+
+	label := game.Label(&Coordinate{X: 0, Y: 1})
+
+	if label != "1 b" {
+		t.Errorf("Unexpected label is returned: %s.", label)
+	}
+}
+
+func TestDefaultUI_Labeling(t *testing.T) {
+	ui := &defaultUI{labeling: &Labeling{LettersOnX: true, ZeroBased: true}}
+	field := buildField(
+		"..",
+		"..",
+	)
+
+	w := bytes.NewBuffer([]byte{})
+	_, err := ui.Render(w, field)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	expected := "  a b\n0| | \n1| | "
+	if w.String() != expected {
+		t.Errorf("Unexpected output: \n%s", w.String())
+	}
+
+	opType, coord, err := ui.ParseInput([]byte("b 0 f"))
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if opType != Flag || coord.X != 1 || coord.Y != 0 {
+		t.Errorf("Unexpected operation is returned: %d %#v.", opType, coord)
+	}
+}
@@ -0,0 +1,233 @@
+package minesweeper
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"time"
+)
+
+// puzzleAttemptsPerPuzzle is the number of fields generated per requested puzzle before GeneratePuzzlePack gives up.
+const puzzleAttemptsPerPuzzle = 1000
+
+// PuzzlePackConfig contains some configuration variables to generate a puzzle pack.
+type PuzzlePackConfig struct {
+	Field *FieldConfig `json:"field" yaml:"field"`
+
+	// Count is the number of puzzles in a pack.
+	Count int `json:"count" yaml:"count"`
+
+	// Seed is the seed of the first generated field; the following fields use seeds incremented by one.
+	Seed int64 `json:"seed" yaml:"seed"`
+
+	// MaxGuesses is the maximum number of forced guesses a puzzle may require.
+	// Fields requiring more guesses are skipped.
+	MaxGuesses int `json:"max_guesses" yaml:"max_guesses"`
+}
+
+// NewPuzzlePackConfig construct PuzzlePackConfig with default values.
+// Use json.Unmarshal, yaml.Unmarshal or manual manipulation to override default values.
+func NewPuzzlePackConfig() *PuzzlePackConfig {
+	return &PuzzlePackConfig{
+		Field:      NewFieldConfig(),
+		Count:      10,
+		Seed:       1,
+		MaxGuesses: 0,
+	}
+}
+
+// Puzzle represents a curated field in a puzzle pack.
+type Puzzle struct {
+	// Seed is the seed used to generate this field.
+	Seed int64 `json:"seed"`
+
+	// Code is the board code given by Field.Code. Use NewFieldFromCode to construct a Field to play.
+	// The code designates the safe start cell that GuessCount is evaluated from.
+	Code string `json:"code"`
+
+	Difficulty Difficulty `json:"difficulty"`
+	ThreeBV    int        `json:"3bv"`
+	GuessCount int        `json:"guess_count"`
+
+	// Par is the number of opens to clear this puzzle without waste, which equals ThreeBV.
+	// Pass this to WithMoveBudget to play the puzzle in a limited-moves mode.
+	Par int `json:"par"`
+}
+
+// PuzzlePack represents a set of puzzles that can be exported for other applications.
+type PuzzlePack struct {
+	Puzzles []*Puzzle `json:"puzzles"`
+}
+
+// GeneratePuzzlePack generates fields with seeds from the configured one and collects those
+// requiring no more guesses than configured until the configured number of puzzles is collected.
+// The same configuration always results in the same puzzle pack.
+func GeneratePuzzlePack(config *PuzzlePackConfig) (*PuzzlePack, error) {
+	return GeneratePuzzlePackContext(context.Background(), config)
+}
+
+// GeneratePuzzlePackContext works in the same way as GeneratePuzzlePack,
+// but stops generation and returns ctx.Err() when given context is canceled before the pack is filled.
+func GeneratePuzzlePackContext(ctx context.Context, config *PuzzlePackConfig) (*PuzzlePack, error) {
+	if config.Field == nil {
+		return nil, errors.New("field config is not given")
+	}
+
+	if config.Count <= 0 {
+		return nil, errors.New("puzzle count is zero")
+	}
+
+	if config.MaxGuesses < 0 {
+		return nil, fmt.Errorf("max guesses must not be negative: %d", config.MaxGuesses)
+	}
+
+	pack := &PuzzlePack{}
+	for seed := config.Seed; len(pack.Puzzles) < config.Count; seed++ {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		if seed-config.Seed >= int64(config.Count*puzzleAttemptsPerPuzzle) {
+			return nil, fmt.Errorf("only %d puzzles satisfy the configuration in %d attempts", len(pack.Puzzles), seed-config.Seed)
+		}
+
+		field, err := NewSeededField(config.Field, seed)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate field: %s", err.Error())
+		}
+
+		// Publish a start cell so the puzzle is solvable as evaluated below
+		field.SafeStart = recommendStart(field)
+		guesses := GuessCount(field)
+		if guesses > config.MaxGuesses {
+			continue
+		}
+
+		threeBV := ThreeBV(field)
+		pack.Puzzles = append(pack.Puzzles, &Puzzle{
+			Seed:       seed,
+			Code:       field.Code(),
+			Difficulty: ClassifyDifficulty(field),
+			ThreeBV:    threeBV,
+			GuessCount: guesses,
+			Par:        threeBV,
+		})
+	}
+
+	return pack, nil
+}
+
+// ReadPuzzlePack reads a puzzle pack in JSON format, which is written by PuzzlePack.WriteJSON, from given io.Reader.
+// An error is returned when any puzzle has an invalid board code.
+func ReadPuzzlePack(r io.Reader) (*PuzzlePack, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	pack := &PuzzlePack{}
+	err = json.Unmarshal(b, pack)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse puzzle pack: %s", err.Error())
+	}
+
+	for i, puzzle := range pack.Puzzles {
+		_, err := NewFieldFromCode(puzzle.Code)
+		if err != nil {
+			return nil, fmt.Errorf("puzzle #%d has invalid code: %s", i+1, err.Error())
+		}
+	}
+
+	return pack, nil
+}
+
+// WriteJSON writes this puzzle pack in JSON format to given io.Writer.
+func (p *PuzzlePack) WriteJSON(w io.Writer) (int, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return 0, err
+	}
+
+	return w.Write(b)
+}
+
+// WriteCSV writes this puzzle pack in CSV format to given io.Writer.
+// The first line is a header of "seed,code,difficulty,3bv,guess_count,par."
+func (p *PuzzlePack) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	err := writer.Write([]string{"seed", "code", "difficulty", "3bv", "guess_count", "par"})
+	if err != nil {
+		return err
+	}
+
+	for _, puzzle := range p.Puzzles {
+		err := writer.Write([]string{
+			strconv.FormatInt(puzzle.Seed, 10),
+			puzzle.Code,
+			puzzle.Difficulty.String(),
+			strconv.Itoa(puzzle.ThreeBV),
+			strconv.Itoa(puzzle.GuessCount),
+			strconv.Itoa(puzzle.Par),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// PuzzleProgress tracks which puzzles are completed and the best time of each.
+// Puzzles are identified by their board codes, so progress is kept across packs that share puzzles.
+// This can be saved and loaded with json.Marshal and json.Unmarshal.
+type PuzzleProgress struct {
+	// Times maps the board code of each completed puzzle to its best time.
+	Times map[string]time.Duration `json:"times"`
+}
+
+// NewPuzzleProgress constructs PuzzleProgress without any completed puzzle.
+func NewPuzzleProgress() *PuzzleProgress {
+	return &PuzzleProgress{
+		Times: map[string]time.Duration{},
+	}
+}
+
+// Complete marks given puzzle as completed in given time, and returns true when this is the best time for the puzzle.
+// Use WithBoardCode to play Puzzle.Code, and pass the time the game took when it is cleared.
+func (p *PuzzleProgress) Complete(puzzle *Puzzle, elapsed time.Duration) bool {
+	if p.Times == nil {
+		p.Times = map[string]time.Duration{}
+	}
+
+	best, ok := p.Times[puzzle.Code]
+	if ok && best <= elapsed {
+		return false
+	}
+
+	p.Times[puzzle.Code] = elapsed
+	return true
+}
+
+// Time returns the best time of given puzzle. False is returned when the puzzle is not completed yet.
+func (p *PuzzleProgress) Time(puzzle *Puzzle) (time.Duration, bool) {
+	elapsed, ok := p.Times[puzzle.Code]
+	return elapsed, ok
+}
+
+// Next returns the first puzzle in given pack that is not completed yet, or nil when every puzzle is completed.
+func (p *PuzzleProgress) Next(pack *PuzzlePack) *Puzzle {
+	for _, puzzle := range pack.Puzzles {
+		if _, ok := p.Times[puzzle.Code]; !ok {
+			return puzzle
+		}
+	}
+	return nil
+}
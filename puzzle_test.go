@@ -0,0 +1,304 @@
+package minesweeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewPuzzlePackConfig(t *testing.T) {
+	config := NewPuzzlePackConfig()
+
+	if config.Field == nil {
+		t.Error("Field should be filled with default configuration.")
+	}
+
+	if config.Count == 0 {
+		t.Error("Count is not set.")
+	}
+}
+
+func TestGeneratePuzzlePack(t *testing.T) {
+	tests := []struct {
+		config   *PuzzlePackConfig
+		hasError bool
+	}{
+		{
+			config: &PuzzlePackConfig{
+				Field:      &FieldConfig{Width: 5, Height: 5, MineCnt: 3},
+				Count:      3,
+				Seed:       1,
+				MaxGuesses: 0,
+			},
+		},
+		{
+			config: &PuzzlePackConfig{
+				Count: 3,
+			},
+			hasError: true,
+		},
+		{
+			config: &PuzzlePackConfig{
+				Field: &FieldConfig{Width: 5, Height: 5, MineCnt: 3},
+				Count: 0,
+			},
+			hasError: true,
+		},
+		{
+			config: &PuzzlePackConfig{
+				Field:      &FieldConfig{Width: 5, Height: 5, MineCnt: 3},
+				Count:      3,
+				MaxGuesses: -1,
+			},
+			hasError: true,
+		},
+		{
+			config: &PuzzlePackConfig{
+				Field: &FieldConfig{Width: 5, Height: 5, MineCnt: 100},
+				Count: 3,
+			},
+			hasError: true,
+		},
+		{
+			// Any 2x2 field with 2 mines requires a guess
+			config: &PuzzlePackConfig{
+				Field:      &FieldConfig{Width: 2, Height: 2, MineCnt: 2},
+				Count:      1,
+				MaxGuesses: 0,
+			},
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			pack, err := GeneratePuzzlePack(test.config)
+
+			if test.hasError {
+				if err == nil {
+					t.Fatal("Expected error is not returned.")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error is returned: %s.", err.Error())
+			}
+
+			if len(pack.Puzzles) != test.config.Count {
+				t.Fatalf("Unexpected number of puzzles: %d.", len(pack.Puzzles))
+			}
+
+			for _, puzzle := range pack.Puzzles {
+				if puzzle.GuessCount > test.config.MaxGuesses {
+					t.Errorf("Puzzle requiring too many guesses is included: %d.", puzzle.GuessCount)
+				}
+
+				field, err := NewFieldFromCode(puzzle.Code)
+				if err != nil {
+					t.Fatalf("Invalid code is set: %s.", puzzle.Code)
+				}
+
+				if field.SafeStart == nil {
+					t.Fatalf("Safe start is not set: %s.", puzzle.Code)
+				}
+
+				if GuessCount(field) != puzzle.GuessCount {
+					t.Errorf("Guess count from the safe start differs: %d.", GuessCount(field))
+				}
+
+				if ThreeBV(field) != puzzle.ThreeBV {
+					t.Errorf("Unexpected 3BV is set: %d.", puzzle.ThreeBV)
+				}
+
+				if puzzle.Par != puzzle.ThreeBV {
+					t.Errorf("Unexpected par is set: %d.", puzzle.Par)
+				}
+			}
+
+			again, _ := GeneratePuzzlePack(test.config)
+			for i, puzzle := range again.Puzzles {
+				if *puzzle != *pack.Puzzles[i] {
+					t.Errorf("Same configuration must result in the same puzzle: %#v.", puzzle)
+				}
+			}
+		})
+	}
+}
+
+func TestGeneratePuzzlePackContext(t *testing.T) {
+	config := &PuzzlePackConfig{
+		Field: &FieldConfig{Width: 5, Height: 5, MineCnt: 3},
+		Count: 3,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pack, err := GeneratePuzzlePackContext(ctx, config)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if len(pack.Puzzles) != 3 {
+		t.Errorf("Unexpected number of puzzles are generated: %d.", len(pack.Puzzles))
+	}
+
+	cancel()
+	_, err = GeneratePuzzlePackContext(ctx, config)
+	if err != context.Canceled {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
+
+func TestPuzzlePack_WriteJSON(t *testing.T) {
+	pack := &PuzzlePack{
+		Puzzles: []*Puzzle{
+			{
+				Seed:       1,
+				Code:       "3x3-hAA",
+				Difficulty: Beginner,
+				ThreeBV:    2,
+				GuessCount: 0,
+				Par:        2,
+			},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	i, err := pack.WriteJSON(buf)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if i == 0 {
+		t.Error("No byte was written.")
+	}
+
+	decoded := map[string][]map[string]interface{}{}
+	err = json.Unmarshal(buf.Bytes(), &decoded)
+	if err != nil {
+		t.Fatalf("Invalid JSON is written: %s.", err.Error())
+	}
+
+	puzzle := decoded["puzzles"][0]
+	for _, jsonField := range []string{"seed", "code", "difficulty", "3bv", "guess_count", "par"} {
+		if _, ok := puzzle[jsonField]; !ok {
+			t.Errorf(`Mandatory field "%s" is not present`, jsonField)
+		}
+	}
+
+	if puzzle["difficulty"] != "Beginner" {
+		t.Errorf("Unexpected difficulty is written: %v.", puzzle["difficulty"])
+	}
+}
+
+func TestPuzzlePack_WriteCSV(t *testing.T) {
+	pack := &PuzzlePack{
+		Puzzles: []*Puzzle{
+			{
+				Seed:       1,
+				Code:       "3x3-hAA",
+				Difficulty: Beginner,
+				ThreeBV:    2,
+				GuessCount: 0,
+				Par:        2,
+			},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	err := pack.WriteCSV(buf)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	expected := "seed,code,difficulty,3bv,guess_count,par\n1,3x3-hAA,Beginner,2,0,2\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected output is given: %s.", strings.TrimSpace(buf.String()))
+	}
+}
+
+func TestReadPuzzlePack(t *testing.T) {
+	pack := &PuzzlePack{
+		Puzzles: []*Puzzle{
+			{
+				Seed:       1,
+				Code:       "3x3-hAA@2,2",
+				Difficulty: Beginner,
+				ThreeBV:    2,
+				GuessCount: 0,
+				Par:        2,
+			},
+		},
+	}
+
+	buf := bytes.NewBuffer([]byte{})
+	_, err := pack.WriteJSON(buf)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	read, err := ReadPuzzlePack(buf)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	if !reflect.DeepEqual(read, pack) {
+		t.Errorf("Unexpected pack is read: %#v.", read.Puzzles[0])
+	}
+
+	_, err = ReadPuzzlePack(strings.NewReader(`{"puzzles":[{"code":"invalid","difficulty":"Beginner"}]}`))
+	if err == nil {
+		t.Error("Expected error is not returned for an invalid code.")
+	}
+}
+
+func TestPuzzleProgress(t *testing.T) {
+	pack := &PuzzlePack{
+		Puzzles: []*Puzzle{
+			{Code: "3x3-hAA"},
+			{Code: "3x3-gAA"},
+		},
+	}
+	progress := NewPuzzleProgress()
+
+	if next := progress.Next(pack); next != pack.Puzzles[0] {
+		t.Fatalf("Unexpected puzzle is returned: %#v.", next)
+	}
+
+	if !progress.Complete(pack.Puzzles[0], 3*time.Second) {
+		t.Error("First completion must be the best time.")
+	}
+
+	if progress.Complete(pack.Puzzles[0], 5*time.Second) {
+		t.Error("Slower completion must not be the best time.")
+	}
+
+	if !progress.Complete(pack.Puzzles[0], 2*time.Second) {
+		t.Error("Faster completion must be the best time.")
+	}
+
+	if elapsed, ok := progress.Time(pack.Puzzles[0]); !ok || elapsed != 2*time.Second {
+		t.Errorf("Unexpected time is returned: %s.", elapsed)
+	}
+
+	if _, ok := progress.Time(pack.Puzzles[1]); ok {
+		t.Error("Uncompleted puzzle has time.")
+	}
+
+	if next := progress.Next(pack); next != pack.Puzzles[1] {
+		t.Fatalf("Unexpected puzzle is returned: %#v.", next)
+	}
+
+	progress.Complete(pack.Puzzles[1], time.Second)
+	if next := progress.Next(pack); next != nil {
+		t.Errorf("Unexpected puzzle is returned: %#v.", next)
+	}
+}
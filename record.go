@@ -0,0 +1,108 @@
+package minesweeper
+
+import (
+	"errors"
+	"time"
+)
+
+// CellRecord tells when a cell was first opened and first flagged.
+// Moves are counted from 1 by each successful operation, and cells opened in a cascade or flagged automatically share the move of the triggering operation.
+// A zero move means the cell has never been opened or flagged, or that it was done before the game was restored.
+type CellRecord struct {
+	OpenedMove  int
+	OpenedAt    time.Time
+	FlaggedMove int
+	FlaggedAt   time.Time
+
+	// openedBefore and flaggedBefore tell that the cell was opened or flagged before the game was restored,
+	// so the record is left unknown instead of being stamped by a later operation.
+	openedBefore  bool
+	flaggedBefore bool
+}
+
+// CellRecord returns CellRecord of the cell at given Coordinate, which helps analyzing the pace of a game.
+// Records are kept in memory only and are not part of the data written by Game.Save,
+// so a cell that was opened or flagged before Restore is reported with a zero move.
+func (g *Game) CellRecord(coord *Coordinate) (*CellRecord, error) {
+	if coord.X < 0 || coord.Y < 0 || coord.X+1 > g.field.Width || coord.Y+1 > g.field.Height {
+		return nil, ErrCoordinateOutOfRange
+	}
+
+	if g.records == nil {
+		return &CellRecord{}, nil
+	}
+
+	record := *g.records[coord.Y][coord.X]
+	return &record, nil
+}
+
+// initRestoredRecords prepares CellRecord of each cell of a restored game.
+// Cells that are already opened or flagged are marked so their records stay unknown.
+func (g *Game) initRestoredRecords() {
+	g.records = make([][]*CellRecord, g.field.Height)
+	for y := range g.records {
+		g.records[y] = make([]*CellRecord, g.field.Width)
+		for x := range g.records[y] {
+			state := g.field.Cells[y][x].State()
+			g.records[y][x] = &CellRecord{
+				openedBefore:  state == Opened || state == Exploded,
+				flaggedBefore: state == Flagged,
+			}
+		}
+	}
+}
+
+// record updates CellRecord of cells that are newly opened or flagged by the latest operation.
+func (g *Game) record() {
+	now := g.now()
+
+	if g.records == nil {
+		g.records = make([][]*CellRecord, g.field.Height)
+		for y := range g.records {
+			g.records[y] = make([]*CellRecord, g.field.Width)
+			for x := range g.records[y] {
+				g.records[y][x] = &CellRecord{}
+			}
+		}
+	}
+
+	for y, row := range g.field.Cells {
+		for x, c := range row {
+			record := g.records[y][x]
+			switch c.State() {
+			case Opened, Exploded:
+				if record.OpenedMove == 0 && !record.openedBefore {
+					record.OpenedMove = g.moves
+					record.OpenedAt = now
+				}
+
+			case Flagged:
+				if record.FlaggedMove == 0 && !record.flaggedBefore {
+					record.FlaggedMove = g.moves
+					record.FlaggedAt = now
+				}
+
+			}
+		}
+	}
+}
+
+// WithClock creates GameOption that feeds given function to tell the current time.
+// The clock is referred to by CellRecord and WithIdlePause, so a host can supply a clock of its own such as one for tests or replays.
+func WithClock(clock func() time.Time) GameOption {
+	return func(g *Game) error {
+		if clock == nil {
+			return errors.New("clock must not be nil")
+		}
+
+		g.clock = clock
+		return nil
+	}
+}
+
+func (g *Game) now() time.Time {
+	if g.clock != nil {
+		return g.clock()
+	}
+	return time.Now()
+}
@@ -0,0 +1,128 @@
+package minesweeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestGame_CellRecord(t *testing.T) {
+	now := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	game := &Game{
+		field: buildField(
+			"*...",
+			"....",
+		),
+		state: InProgress,
+		quota: 7,
+		clock: func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		},
+	}
+
+	record, err := game.CellRecord(&Coordinate{X: 3, Y: 0})
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+	if record.OpenedMove != 0 || record.FlaggedMove != 0 {
+		t.Errorf("Unexpected record is returned before any move: %#v.", record)
+	}
+
+	_, _ = game.operate(Flag, &Coordinate{X: 0, Y: 0})
+	_, _ = game.operate(Open, &Coordinate{X: 3, Y: 0})
+
+	tests := []struct {
+		coord       *Coordinate
+		openedMove  int
+		flaggedMove int
+	}{
+		{
+			coord:       &Coordinate{X: 0, Y: 0},
+			flaggedMove: 1,
+		},
+		{
+			coord:      &Coordinate{X: 3, Y: 0},
+			openedMove: 2,
+		},
+		{
+			// Opened in a cascade
+			coord:      &Coordinate{X: 1, Y: 1},
+			openedMove: 2,
+		},
+	}
+
+	for _, test := range tests {
+		record, _ := game.CellRecord(test.coord)
+
+		if record.OpenedMove != test.openedMove || record.FlaggedMove != test.flaggedMove {
+			t.Errorf("Unexpected record is returned for %d:%d: %#v.", test.coord.X, test.coord.Y, record)
+		}
+	}
+
+	record, _ = game.CellRecord(&Coordinate{X: 3, Y: 0})
+	if !record.OpenedAt.Equal(time.Date(2017, 1, 1, 0, 0, 2, 0, time.UTC)) {
+		t.Errorf("Unexpected time is recorded: %s.", record.OpenedAt)
+	}
+
+	_, err = game.CellRecord(&Coordinate{X: 4, Y: 0})
+	if err != ErrCoordinateOutOfRange {
+		t.Errorf("Expected error is not returned: %v.", err)
+	}
+}
+
+func TestGame_CellRecord_Restore(t *testing.T) {
+	game := &Game{
+		field: buildField(
+			"*...",
+			"*...",
+		),
+		state: InProgress,
+		quota: 6,
+	}
+
+	_, _ = game.operate(Flag, &Coordinate{X: 0, Y: 0})
+	_, _ = game.operate(Open, &Coordinate{X: 1, Y: 0})
+
+	saved := bytes.NewBuffer([]byte{})
+	_, err := game.Save(saved)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	restored, err := Restore(saved)
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	_, _ = restored.operate(Unflag, &Coordinate{X: 0, Y: 0})
+	_, _ = restored.operate(Flag, &Coordinate{X: 0, Y: 0})
+	_, _ = restored.operate(Open, &Coordinate{X: 3, Y: 0})
+
+	tests := []struct {
+		coord       *Coordinate
+		openedMove  int
+		flaggedMove int
+	}{
+		{
+			// Flagged before the restore
+			coord: &Coordinate{X: 0, Y: 0},
+		},
+		{
+			// Opened before the restore
+			coord: &Coordinate{X: 1, Y: 0},
+		},
+		{
+			coord:      &Coordinate{X: 3, Y: 0},
+			openedMove: 5,
+		},
+	}
+
+	for _, test := range tests {
+		record, _ := restored.CellRecord(test.coord)
+
+		if record.OpenedMove != test.openedMove || record.FlaggedMove != test.flaggedMove {
+			t.Errorf("Unexpected record is returned for %d:%d: %#v.", test.coord.X, test.coord.Y, record)
+		}
+	}
+}
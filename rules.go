@@ -0,0 +1,161 @@
+package minesweeper
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Verdict represents a decision made by Rules on each operation.
+type Verdict int
+
+const (
+	_ Verdict = iota
+
+	// Continue represents a verdict that the game goes on.
+	Continue
+
+	// LoseLife represents a verdict that a user loses one life and the game goes on.
+	LoseLife
+
+	// Win represents a verdict that the game is cleared.
+	Win
+
+	// Lose represents a verdict that the game is lost.
+	Lose
+)
+
+// Situation is what Rules refer to when judging an operation.
+// This reflects the state right after the operation is applied.
+type Situation struct {
+	// Field is the field of the game. Rules must not modify this.
+	Field *Field
+
+	// OpType is the type of the applied operation.
+	OpType OpType
+
+	// Coordinate is the location of the applied operation.
+	Coordinate *Coordinate
+
+	// Result is the result of the applied operation.
+	Result *Result
+
+	// Quota is the number of safe cells to be opened.
+	Quota int
+
+	// Opened is the number of safe cells that are opened so far.
+	Opened int
+
+	// Lives is the number of remaining lives. A value of 1 or less means there is no spare life.
+	Lives int
+
+	// Moves is the number of successful operations including the one being judged.
+	Moves int
+
+	// Opens is the number of successful open operations including the one being judged.
+	// Unlike Moves, flagging and unflagging are not counted.
+	Opens int
+}
+
+// Rules decides whether a game is won, lost or goes on after each successful operation.
+// Implement this to introduce a new game mode without modifying Game, and pass it via WithRules.
+type Rules interface {
+	Judge(situation *Situation) Verdict
+}
+
+// StandardRules is the default Rules.
+// A game is won when all safe cells are opened and is lost when a mine explodes with no spare life left.
+type StandardRules struct{}
+
+// Judge judges given Situation.
+func (*StandardRules) Judge(s *Situation) Verdict {
+	if s.OpType != Open {
+		return Continue
+	}
+
+	if v := judgeExplosion(s); v != Continue {
+		return v
+	}
+
+	if s.Opened == s.Quota {
+		return Win
+	}
+	return Continue
+}
+
+// FlagAllMinesRules requires a user to flag every mine in addition to opening every safe cell.
+// Explosions are judged in the same way as StandardRules.
+// A mine exploded with a spare life given by WithLives can never be flagged, so it is taken as found.
+type FlagAllMinesRules struct{}
+
+// Judge judges given Situation.
+func (*FlagAllMinesRules) Judge(s *Situation) Verdict {
+	if v := judgeExplosion(s); v != Continue {
+		return v
+	}
+
+	if s.Opened != s.Quota {
+		return Continue
+	}
+
+	for _, row := range s.Field.Cells {
+		for _, c := range row {
+			if c.hasMine() && c.State() != Flagged && c.State() != Exploded {
+				return Continue
+			}
+		}
+	}
+	return Win
+}
+
+// MoveBudgetRules limits the number of opens per game, which makes a "minesweeper golf" mode.
+// A game that is not cleared within Budget opens is lost; other judgements are the same as StandardRules.
+// Flagging and unflagging are free so the budget is comparable to Puzzle.Par, which is a reasonable budget for a puzzle in a puzzle pack.
+// Budget must be positive; WithMoveBudget and WithRules reject a game with other values.
+type MoveBudgetRules struct {
+	Budget int
+}
+
+// Judge judges given Situation.
+func (r *MoveBudgetRules) Judge(s *Situation) Verdict {
+	verdict := (&StandardRules{}).Judge(s)
+	if verdict == Continue && s.OpType == Open && s.Opens >= r.Budget {
+		return Lose
+	}
+	return verdict
+}
+
+// SandboxRules never lets a user lose; an exploded cell is just marked and the game goes on until all safe cells are opened.
+type SandboxRules struct{}
+
+// Judge judges given Situation.
+func (*SandboxRules) Judge(s *Situation) Verdict {
+	if s.OpType == Open && s.Opened == s.Quota {
+		return Win
+	}
+	return Continue
+}
+
+// validateRules checks if given Rules can be applied to a game.
+func validateRules(rules Rules) error {
+	if rules == nil {
+		return errors.New("rules must not be nil")
+	}
+
+	if r, ok := rules.(*MoveBudgetRules); ok && r.Budget <= 0 {
+		return fmt.Errorf("move budget must be positive: %d", r.Budget)
+	}
+
+	return nil
+}
+
+// judgeExplosion returns LoseLife or Lose when the applied operation exploded a mine; Continue otherwise.
+func judgeExplosion(s *Situation) Verdict {
+	if s.OpType != Open || s.Result.NewState != Exploded {
+		return Continue
+	}
+
+	if s.Lives > 1 {
+		return LoseLife
+	}
+	return Lose
+}
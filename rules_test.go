@@ -0,0 +1,204 @@
+package minesweeper
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStandardRules_Judge(t *testing.T) {
+	tests := []struct {
+		situation *Situation
+		verdict   Verdict
+	}{
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Opened}, Quota: 3, Opened: 2},
+			verdict:   Continue,
+		},
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Opened}, Quota: 3, Opened: 3},
+			verdict:   Win,
+		},
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Exploded}, Quota: 3, Opened: 2},
+			verdict:   Lose,
+		},
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Exploded}, Quota: 3, Opened: 2, Lives: 2},
+			verdict:   LoseLife,
+		},
+		{
+			situation: &Situation{OpType: Flag, Result: &Result{NewState: Flagged}, Quota: 3, Opened: 3},
+			verdict:   Continue,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			verdict := (&StandardRules{}).Judge(test.situation)
+
+			if verdict != test.verdict {
+				t.Errorf("Unexpected verdict is returned: %d.", verdict)
+			}
+		})
+	}
+}
+
+func TestFlagAllMinesRules_Judge(t *testing.T) {
+	unflagged := buildField("*.")
+	unflagged.Cells[0][1].(*cell).state = Opened
+
+	flagged := buildField("*.")
+	flagged.Cells[0][0].(*cell).state = Flagged
+	flagged.Cells[0][1].(*cell).state = Opened
+
+	exploded := buildField("*.*")
+	exploded.Cells[0][0].(*cell).state = Flagged
+	exploded.Cells[0][1].(*cell).state = Opened
+	exploded.Cells[0][2].(*cell).state = Exploded
+
+	tests := []struct {
+		situation *Situation
+		verdict   Verdict
+	}{
+		{
+			situation: &Situation{Field: unflagged, OpType: Open, Result: &Result{NewState: Opened}, Quota: 1, Opened: 1},
+			verdict:   Continue,
+		},
+		{
+			situation: &Situation{Field: flagged, OpType: Flag, Result: &Result{NewState: Flagged}, Quota: 1, Opened: 1},
+			verdict:   Win,
+		},
+		{
+			situation: &Situation{Field: flagged, OpType: Open, Result: &Result{NewState: Opened}, Quota: 2, Opened: 1},
+			verdict:   Continue,
+		},
+		{
+			situation: &Situation{Field: unflagged, OpType: Open, Result: &Result{NewState: Exploded}, Quota: 1, Opened: 0},
+			verdict:   Lose,
+		},
+		{
+			situation: &Situation{Field: unflagged, OpType: Open, Result: &Result{NewState: Exploded}, Quota: 1, Opened: 0, Lives: 2},
+			verdict:   LoseLife,
+		},
+		{
+			// A mine exploded with a spare life is taken as found
+			situation: &Situation{Field: exploded, OpType: Flag, Result: &Result{NewState: Flagged}, Quota: 1, Opened: 1, Lives: 1},
+			verdict:   Win,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			verdict := (&FlagAllMinesRules{}).Judge(test.situation)
+
+			if verdict != test.verdict {
+				t.Errorf("Unexpected verdict is returned: %d.", verdict)
+			}
+		})
+	}
+}
+
+func TestMoveBudgetRules_Judge(t *testing.T) {
+	tests := []struct {
+		situation *Situation
+		verdict   Verdict
+	}{
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Opened}, Quota: 3, Opened: 2, Moves: 1, Opens: 1},
+			verdict:   Continue,
+		},
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Opened}, Quota: 3, Opened: 2, Moves: 2, Opens: 2},
+			verdict:   Lose,
+		},
+		{
+			// Flags are free
+			situation: &Situation{OpType: Flag, Result: &Result{NewState: Flagged}, Quota: 3, Opened: 2, Moves: 3, Opens: 1},
+			verdict:   Continue,
+		},
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Opened}, Quota: 3, Opened: 3, Moves: 2, Opens: 2},
+			verdict:   Win,
+		},
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Exploded}, Quota: 3, Opened: 2, Moves: 1, Opens: 1, Lives: 2},
+			verdict:   LoseLife,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			verdict := (&MoveBudgetRules{Budget: 2}).Judge(test.situation)
+
+			if verdict != test.verdict {
+				t.Errorf("Unexpected verdict is returned: %d.", verdict)
+			}
+		})
+	}
+}
+
+func Test_validateRules(t *testing.T) {
+	tests := []struct {
+		rules    Rules
+		hasError bool
+	}{
+		{
+			rules: &StandardRules{},
+		},
+		{
+			rules: &MoveBudgetRules{Budget: 1},
+		},
+		{
+			rules:    &MoveBudgetRules{Budget: 0},
+			hasError: true,
+		},
+		{
+			rules:    nil,
+			hasError: true,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			err := validateRules(test.rules)
+
+			if test.hasError && err == nil {
+				t.Error("Expected error is not returned.")
+			}
+
+			if !test.hasError && err != nil {
+				t.Errorf("Unexpected error is returned: %s.", err.Error())
+			}
+		})
+	}
+}
+
+func TestSandboxRules_Judge(t *testing.T) {
+	tests := []struct {
+		situation *Situation
+		verdict   Verdict
+	}{
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Exploded}, Quota: 3, Opened: 2},
+			verdict:   Continue,
+		},
+		{
+			situation: &Situation{OpType: Open, Result: &Result{NewState: Opened}, Quota: 3, Opened: 3},
+			verdict:   Win,
+		},
+		{
+			situation: &Situation{OpType: Flag, Result: &Result{NewState: Flagged}, Quota: 3, Opened: 2},
+			verdict:   Continue,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			verdict := (&SandboxRules{}).Judge(test.situation)
+
+			if verdict != test.verdict {
+				t.Errorf("Unexpected verdict is returned: %d.", verdict)
+			}
+		})
+	}
+}
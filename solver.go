@@ -0,0 +1,595 @@
+package minesweeper
+
+import (
+	"sort"
+)
+
+// solverNodeLimit caps the number of search nodes spent to enumerate mine placements around a group of frontier cells.
+// A group that exceeds this is left to the simple rules so the solver stays responsive on large fields.
+const solverNodeLimit = 200000
+
+// Deduction contains cells that are certainly safe or certainly mined.
+type Deduction struct {
+	Safe  []*Coordinate
+	Mines []*Coordinate
+}
+
+// Deduce returns cells that are certainly safe or certainly mined judging only from information visible to a player:
+// surrounding counts of opened cells and positions of exploded mines.
+// Flags are not trusted since a user may place them wrongly.
+//
+// Cells are deduced with simple rules first, and when that is not enough,
+// by enumerating every mine placement that is consistent with surrounding counts.
+func Deduce(field *Field) *Deduction {
+	return newKnowledge(field).deduce()
+}
+
+// GuessCount returns the number of guesses a player is forced to make to clear given Field with deductions by Deduce.
+//
+// The first click is not counted. A player starts on Field.SafeStart when it is set,
+// and is otherwise assumed to start on an opening when there is any.
+// When no cell can be deduced, a safe cell is picked as a lucky guess and counted;
+// one that reveals an opening is preferred to reduce further guesses.
+// Since the total mine count is not taken into account, this may be slightly larger than what a perfect player faces.
+// Zero is returned for a field without any safe cell.
+// States of cells are ignored and the field is solved from scratch; use RemainingGuessCount for a game in progress.
+func GuessCount(field *Field) int {
+	k := &knowledge{
+		field:  field,
+		opened: newBoolGrid(field.Width, field.Height),
+		mine:   newBoolGrid(field.Width, field.Height),
+	}
+
+	start := field.SafeStart
+	if start == nil {
+		start = k.guess()
+	}
+	if start == nil {
+		// No safe cell to open at all
+		return 0
+	}
+	k.open(start)
+
+	return k.guessesToClear()
+}
+
+// RemainingGuessCount returns the number of guesses a player is forced to make to clear given Field from its current position
+// in the same way GuessCount does.
+//
+// Opened and exploded cells are taken as known in the same way as Deduce.
+// Flags are not trusted since a flag may be placed by a lucky guess, so a flagged cell has to be resolved in the same way as a closed cell.
+// When no cell is opened yet, this works in the same way as GuessCount, so the first click is not counted.
+func RemainingGuessCount(field *Field) int {
+	k := newKnowledge(field)
+	if k.openedCnt == 0 {
+		return GuessCount(field)
+	}
+
+	return k.guessesToClear()
+}
+
+// guessesToClear opens cells by deductions and lucky guesses until every safe cell is opened,
+// and returns the number of guesses made.
+func (k *knowledge) guessesToClear() int {
+	safe := 0
+	for _, row := range k.field.Cells {
+		for _, c := range row {
+			if !c.hasMine() {
+				safe++
+			}
+		}
+	}
+
+	guesses := 0
+	for k.openedCnt < safe {
+		deduction := k.deduce()
+		for _, c := range deduction.Mines {
+			k.mine[c.Y][c.X] = true
+		}
+
+		if len(deduction.Safe) > 0 {
+			for _, c := range deduction.Safe {
+				k.open(c)
+			}
+			continue
+		}
+
+		if len(deduction.Mines) > 0 {
+			// Newly found mines may lead to further deduction
+			continue
+		}
+
+		guess := k.guess()
+		if guess == nil {
+			// Nothing is left to open
+			break
+		}
+		guesses++
+		k.open(guess)
+	}
+
+	return guesses
+}
+
+// knowledge represents what a player knows about a field.
+type knowledge struct {
+	field     *Field
+	opened    [][]bool
+	mine      [][]bool
+	openedCnt int
+}
+
+// recommendStart returns a safe cell to start with, preferring one that reveals an opening.
+// This refers to underlying mines, so this must only be used to publish a field.
+func recommendStart(field *Field) *Coordinate {
+	k := &knowledge{
+		field:  field,
+		opened: newBoolGrid(field.Width, field.Height),
+		mine:   newBoolGrid(field.Width, field.Height),
+	}
+	return k.guess()
+}
+
+func newKnowledge(field *Field) *knowledge {
+	k := &knowledge{
+		field:  field,
+		opened: newBoolGrid(field.Width, field.Height),
+		mine:   newBoolGrid(field.Width, field.Height),
+	}
+
+	for y, row := range field.Cells {
+		for x, c := range row {
+			switch c.State() {
+			case Opened:
+				k.opened[y][x] = true
+				k.openedCnt++
+
+			case Exploded:
+				k.mine[y][x] = true
+
+			}
+		}
+	}
+
+	return k
+}
+
+func newBoolGrid(width int, height int) [][]bool {
+	grid := make([][]bool, height)
+	for i := range grid {
+		grid[i] = make([]bool, width)
+	}
+	return grid
+}
+
+func (k *knowledge) unknown(coord *Coordinate) bool {
+	return !k.opened[coord.Y][coord.X] && !k.mine[coord.Y][coord.X]
+}
+
+// open reveals the cell at given Coordinate along with its surroundings in the same way Field.Open does.
+// This refers to underlying mines, so this must only be called for a safe cell.
+func (k *knowledge) open(coord *Coordinate) {
+	if k.opened[coord.Y][coord.X] {
+		return
+	}
+	k.opened[coord.Y][coord.X] = true
+	k.openedCnt++
+
+	if k.field.Cells[coord.Y][coord.X].SurroundingCnt() > 0 {
+		return
+	}
+
+	for _, c := range k.field.getSurroundingCoordinates(coord) {
+		if k.unknown(c) {
+			k.open(c)
+		}
+	}
+}
+
+// guess picks a safe cell to open when nothing can be deduced.
+// This refers to underlying mines, which is only allowed to simulate a lucky guess.
+func (k *knowledge) guess() *Coordinate {
+	var frontier *Coordinate
+	var other *Coordinate
+	for y, row := range k.field.Cells {
+		for x, c := range row {
+			coord := &Coordinate{X: x, Y: y}
+			if c.hasMine() || !k.unknown(coord) {
+				continue
+			}
+
+			if c.SurroundingCnt() == 0 {
+				return coord
+			}
+
+			if frontier == nil && k.isFrontier(coord) {
+				frontier = coord
+			}
+
+			if other == nil {
+				other = coord
+			}
+		}
+	}
+
+	if frontier != nil {
+		return frontier
+	}
+	return other
+}
+
+func (k *knowledge) isFrontier(coord *Coordinate) bool {
+	for _, c := range k.field.getSurroundingCoordinates(coord) {
+		if k.opened[c.Y][c.X] {
+			return true
+		}
+	}
+	return false
+}
+
+// constraint tells that given number of mines are hidden among given cells.
+type constraint struct {
+	cells []int
+	mines int
+}
+
+func (k *knowledge) index(coord *Coordinate) int {
+	return coord.Y*k.field.Width + coord.X
+}
+
+func (k *knowledge) coordinate(i int) *Coordinate {
+	return &Coordinate{X: i % k.field.Width, Y: i / k.field.Width}
+}
+
+// constraints returns constraints given by surrounding counts of opened cells.
+// Cells in given sets of deduced safe cells and mines are treated as known.
+func (k *knowledge) constraints(safe map[int]bool, mines map[int]bool) []*constraint {
+	var constraints []*constraint
+	for y, row := range k.opened {
+		for x, opened := range row {
+			if !opened {
+				continue
+			}
+
+			cons := &constraint{mines: k.field.Cells[y][x].SurroundingCnt()}
+			for _, c := range k.field.getSurroundingCoordinates(&Coordinate{X: x, Y: y}) {
+				i := k.index(c)
+				if k.mine[c.Y][c.X] || mines[i] {
+					cons.mines--
+				} else if !k.opened[c.Y][c.X] && !safe[i] {
+					cons.cells = append(cons.cells, i)
+				}
+			}
+
+			if len(cons.cells) > 0 {
+				sort.Ints(cons.cells)
+				constraints = append(constraints, cons)
+			}
+		}
+	}
+	return constraints
+}
+
+func (k *knowledge) deduce() *Deduction {
+	safe := map[int]bool{}
+	mines := map[int]bool{}
+
+	// Apply simple rules until nothing new is found since a deduced cell may lead to further deduction
+	for applyRules(k.constraints(safe, mines), safe, mines) {
+	}
+
+	// Enumeration of all consistent mine placements
+	if len(safe) == 0 && len(mines) == 0 {
+		for _, group := range groupConstraints(k.constraints(safe, mines)) {
+			placements := enumerate(group)
+			if placements == nil {
+				continue
+			}
+
+			for i, cnt := range placements.mineCnts {
+				if cnt == 0 {
+					safe[i] = true
+				} else if cnt == placements.total {
+					mines[i] = true
+				}
+			}
+		}
+	}
+
+	return &Deduction{
+		Safe:  k.coordinates(safe),
+		Mines: k.coordinates(mines),
+	}
+}
+
+// applyRules adds cells deduced by simple rules to given sets of safe cells and mines.
+// This returns true when at least one cell is newly deduced.
+func applyRules(constraints []*constraint, safe map[int]bool, mines map[int]bool) bool {
+	found := false
+	mark := func(cells []int, set map[int]bool) {
+		for _, i := range cells {
+			if !set[i] {
+				set[i] = true
+				found = true
+			}
+		}
+	}
+
+	// Single constraint: all cells are safe or all are mined
+	for _, cons := range constraints {
+		if cons.mines == 0 {
+			mark(cons.cells, safe)
+		} else if cons.mines == len(cons.cells) {
+			mark(cons.cells, mines)
+		}
+	}
+
+	// Pair of constraints: when one's cells are a subset of another's, the difference holds the difference of mines
+	for _, a := range constraints {
+		for _, b := range constraints {
+			if a == b || len(a.cells) >= len(b.cells) {
+				continue
+			}
+
+			diff, ok := difference(b.cells, a.cells)
+			if !ok {
+				continue
+			}
+
+			m := b.mines - a.mines
+			if m == 0 {
+				mark(diff, safe)
+			} else if m == len(diff) {
+				mark(diff, mines)
+			}
+		}
+	}
+
+	return found
+}
+
+func (k *knowledge) coordinates(set map[int]bool) []*Coordinate {
+	indexes := make([]int, 0, len(set))
+	for i := range set {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+
+	coords := make([]*Coordinate, len(indexes))
+	for i, index := range indexes {
+		coords[i] = k.coordinate(index)
+	}
+	return coords
+}
+
+// difference returns elements of a that are not in b.
+// ok is false when b is not a subset of a.
+// Both slices must be sorted.
+func difference(a []int, b []int) ([]int, bool) {
+	var diff []int
+	j := 0
+	for _, v := range a {
+		if j < len(b) && b[j] == v {
+			j++
+			continue
+		}
+		diff = append(diff, v)
+	}
+	return diff, j == len(b)
+}
+
+// groupConstraints splits constraints into groups that share no cell with each other
+// so each group can be enumerated independently.
+func groupConstraints(constraints []*constraint) [][]*constraint {
+	parent := make([]int, len(constraints))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	owner := map[int]int{}
+	for i, cons := range constraints {
+		for _, cell := range cons.cells {
+			if j, ok := owner[cell]; ok {
+				parent[find(i)] = find(j)
+			} else {
+				owner[cell] = i
+			}
+		}
+	}
+
+	var groups [][]*constraint
+	indexes := map[int]int{}
+	for i, cons := range constraints {
+		root := find(i)
+		index, ok := indexes[root]
+		if !ok {
+			index = len(groups)
+			indexes[root] = index
+			groups = append(groups, nil)
+		}
+		groups[index] = append(groups[index], cons)
+	}
+	return groups
+}
+
+// placements contains the result of enumerating mine placements.
+type placements struct {
+	// total is the number of consistent placements.
+	total int
+
+	// mineCnts maps a cell index to the number of consistent placements that have a mine on the cell.
+	mineCnts map[int]int
+}
+
+// enumerate counts every mine placement on cells of given constraints that satisfies all of them.
+// nil is returned when the search exceeds solverNodeLimit.
+func enumerate(constraints []*constraint) *placements {
+	var cells []int
+	cellConstraints := map[int][]int{}
+	for i, cons := range constraints {
+		for _, cell := range cons.cells {
+			if _, ok := cellConstraints[cell]; !ok {
+				cells = append(cells, cell)
+			}
+			cellConstraints[cell] = append(cellConstraints[cell], i)
+		}
+	}
+	sort.Ints(cells)
+
+	placed := make([]int, len(constraints))
+	remaining := make([]int, len(constraints))
+	for i, cons := range constraints {
+		remaining[i] = len(cons.cells)
+	}
+
+	result := &placements{mineCnts: map[int]int{}}
+	assigned := make([]bool, len(cells))
+	nodes := 0
+
+	var search func(depth int) bool
+	search = func(depth int) bool {
+		nodes++
+		if nodes > solverNodeLimit {
+			return false
+		}
+
+		if depth == len(cells) {
+			result.total++
+			for i, mine := range assigned {
+				if mine {
+					result.mineCnts[cells[i]]++
+				}
+			}
+			return true
+		}
+
+		for _, mine := range []bool{false, true} {
+			feasible := true
+			for _, i := range cellConstraints[cells[depth]] {
+				remaining[i]--
+				if mine {
+					placed[i]++
+				}
+				if placed[i] > constraints[i].mines || placed[i]+remaining[i] < constraints[i].mines {
+					feasible = false
+				}
+			}
+
+			if feasible {
+				assigned[depth] = mine
+				if !search(depth + 1) {
+					return false
+				}
+			}
+
+			for _, i := range cellConstraints[cells[depth]] {
+				remaining[i]++
+				if mine {
+					placed[i]--
+				}
+			}
+		}
+		assigned[depth] = false
+
+		return true
+	}
+
+	if !search(0) || result.total == 0 {
+		return nil
+	}
+
+	for _, cell := range cells {
+		if _, ok := result.mineCnts[cell]; !ok {
+			result.mineCnts[cell] = 0
+		}
+	}
+	return result
+}
+
+// MineProbabilities returns the probability of each cell having a mine indexed as [y][x] in the same way Game.Probabilities does.
+// The total number of mines is taken from given Field.
+func MineProbabilities(field *Field) [][]float64 {
+	mineCnt := 0
+	for _, row := range field.Cells {
+		for _, c := range row {
+			if c.hasMine() {
+				mineCnt++
+			}
+		}
+	}
+	return mineProbabilities(field, mineCnt)
+}
+
+// mineProbabilities returns the probability of each cell having a mine indexed as [y][x],
+// judging only from information visible to a player and given total number of mines.
+//
+// Each group of frontier cells is weighted by its own consistent placements,
+// and the rest of the mines is spread evenly over cells that are not adjacent to any opened cell.
+// This does not weight placements by the number of ways to place the rest of the mines, so this is an approximation,
+// but is good enough to tell risky cells from safer ones.
+func mineProbabilities(field *Field, mineCnt int) [][]float64 {
+	k := newKnowledge(field)
+	probabilities := make([][]float64, field.Height)
+	for i := range probabilities {
+		probabilities[i] = make([]float64, field.Width)
+	}
+
+	remaining := float64(mineCnt)
+	constrained := map[int]bool{}
+	for _, group := range groupConstraints(k.constraints(nil, nil)) {
+		placements := enumerate(group)
+		if placements == nil {
+			// Left to the even spread below
+			continue
+		}
+
+		for i, cnt := range placements.mineCnts {
+			p := float64(cnt) / float64(placements.total)
+			c := k.coordinate(i)
+			probabilities[c.Y][c.X] = p
+			remaining -= p
+			constrained[i] = true
+		}
+	}
+
+	var others []*Coordinate
+	for y, row := range k.opened {
+		for x, opened := range row {
+			coord := &Coordinate{X: x, Y: y}
+			switch {
+			case opened, constrained[k.index(coord)]:
+				continue
+
+			case k.mine[y][x]:
+				probabilities[y][x] = 1
+				remaining--
+
+			default:
+				others = append(others, coord)
+
+			}
+		}
+	}
+
+	if len(others) > 0 {
+		p := remaining / float64(len(others))
+		if p < 0 {
+			p = 0
+		} else if p > 1 {
+			p = 1
+		}
+
+		for _, c := range others {
+			probabilities[c.Y][c.X] = p
+		}
+	}
+
+	return probabilities
+}
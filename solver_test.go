@@ -0,0 +1,388 @@
+package minesweeper
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestDeduce(t *testing.T) {
+	tests := []struct {
+		field  *Field
+		opened []*Coordinate
+		safe   []*Coordinate
+		mines  []*Coordinate
+	}{
+		{
+			// Nothing is opened
+			field: buildField(
+				"*..",
+				"...",
+			),
+		},
+		{
+			// Single constraint with a mine
+			field: buildField(
+				"*..",
+			),
+			opened: []*Coordinate{{X: 1, Y: 0}, {X: 2, Y: 0}},
+			mines:  []*Coordinate{{X: 0, Y: 0}},
+		},
+		{
+			// Single constraint without a mine, which then leaves one cell for another constraint
+			field: buildField(
+				"*....",
+			),
+			opened: []*Coordinate{{X: 1, Y: 0}, {X: 3, Y: 0}},
+			safe:   []*Coordinate{{X: 2, Y: 0}, {X: 4, Y: 0}},
+			mines:  []*Coordinate{{X: 0, Y: 0}},
+		},
+		{
+			// Subset: cells around 0:1 are a subset of those around 1:1, and both have one mine
+			field: buildField(
+				"*..",
+				"...",
+				"...",
+			),
+			opened: []*Coordinate{{X: 0, Y: 1}, {X: 1, Y: 1}},
+			safe:   []*Coordinate{{X: 2, Y: 0}, {X: 2, Y: 1}, {X: 2, Y: 2}},
+		},
+		{
+			// 1-2-1 pattern: mines found by subset rule lead to a safe cell
+			field: buildField(
+				"*.*",
+				"...",
+			),
+			opened: []*Coordinate{{X: 0, Y: 1}, {X: 1, Y: 1}, {X: 2, Y: 1}},
+			mines:  []*Coordinate{{X: 0, Y: 0}, {X: 2, Y: 0}},
+			safe:   []*Coordinate{{X: 1, Y: 0}},
+		},
+		{
+			// 50:50 can not be deduced
+			field: buildField(
+				"*.",
+				"..",
+			),
+			opened: []*Coordinate{{X: 0, Y: 1}, {X: 1, Y: 1}},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			for _, c := range test.opened {
+				test.field.Cells[c.Y][c.X].(*cell).state = Opened
+			}
+
+			deduction := Deduce(test.field)
+
+			if len(deduction.Safe) != len(test.safe) || (len(test.safe) > 0 && !reflect.DeepEqual(deduction.Safe, test.safe)) {
+				t.Errorf("Unexpected safe cells are returned: %s.", coordsString(deduction.Safe))
+			}
+
+			if len(deduction.Mines) != len(test.mines) || (len(test.mines) > 0 && !reflect.DeepEqual(deduction.Mines, test.mines)) {
+				t.Errorf("Unexpected mines are returned: %s.", coordsString(deduction.Mines))
+			}
+		})
+	}
+}
+
+func TestGuessCount(t *testing.T) {
+	tests := []struct {
+		field    *Field
+		expected int
+	}{
+		{
+			field: buildField(
+				"*....",
+				".....",
+				".....",
+			),
+			expected: 0,
+		},
+		{
+			field: buildField(
+				"*.*..",
+				".....",
+				".....",
+			),
+			expected: 0,
+		},
+		{
+			// The corner is a 50:50 after the opening is revealed
+			field: buildField(
+				"*.....",
+				"......",
+				"......",
+				".....*",
+			),
+			expected: 0,
+		},
+		{
+			field: buildField(
+				"*.",
+				"..",
+				"..",
+			),
+			expected: 1,
+		},
+		{
+			// No opening at all
+			field: buildField(
+				"*.*",
+				".*.",
+				"*.*",
+			),
+			expected: 3,
+		},
+		{
+			// The opening on the right would solve the row, but the designated start leaves a 50:50
+			field: func() *Field {
+				field := buildField("*..")
+				field.SafeStart = &Coordinate{X: 1, Y: 0}
+				return field
+			}(),
+			expected: 1,
+		},
+		{
+			// Every cell is mined
+			field:    buildField("**"),
+			expected: 0,
+		},
+		{
+			field:    buildField("*"),
+			expected: 0,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			cnt := GuessCount(test.field)
+			if cnt != test.expected {
+				t.Errorf("Expected %d, but %d was returned.", test.expected, cnt)
+			}
+		})
+	}
+}
+
+func TestRemainingGuessCount(t *testing.T) {
+	withStates := func(field *Field, states map[Coordinate]CellState) *Field {
+		for coord, state := range states {
+			field.Cells[coord.Y][coord.X].(*cell).state = state
+		}
+		return field
+	}
+
+	tests := []struct {
+		field    *Field
+		expected int
+	}{
+		{
+			// Already solved
+			field: withStates(buildField(
+				"*.",
+				"..",
+			), map[Coordinate]CellState{{X: 0, Y: 0}: Flagged, {X: 1, Y: 0}: Opened, {X: 0, Y: 1}: Opened, {X: 1, Y: 1}: Opened}),
+			expected: 0,
+		},
+		{
+			// GuessCount starts on the opening, but the position given is already a 50:50
+			field:    withStates(buildField("*.."), map[Coordinate]CellState{{X: 1, Y: 0}: Opened}),
+			expected: 1,
+		},
+		{
+			// A flag tells nothing even when it is placed on a mine
+			field:    withStates(buildField("*.."), map[Coordinate]CellState{{X: 0, Y: 0}: Flagged, {X: 1, Y: 0}: Opened}),
+			expected: 1,
+		},
+		{
+			field:    withStates(buildField("*.."), map[Coordinate]CellState{{X: 2, Y: 0}: Flagged, {X: 1, Y: 0}: Opened}),
+			expected: 1,
+		},
+		{
+			// The exploded mine is known
+			field:    withStates(buildField("*.."), map[Coordinate]CellState{{X: 0, Y: 0}: Exploded, {X: 1, Y: 0}: Opened}),
+			expected: 0,
+		},
+		{
+			// Nothing is opened yet
+			field: buildField(
+				"*.",
+				"..",
+				"..",
+			),
+			expected: 1,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			cnt := RemainingGuessCount(test.field)
+			if cnt != test.expected {
+				t.Errorf("Expected %d, but %d was returned.", test.expected, cnt)
+			}
+		})
+	}
+}
+
+func Test_difference(t *testing.T) {
+	tests := []struct {
+		a    []int
+		b    []int
+		diff []int
+		ok   bool
+	}{
+		{
+			a:    []int{1, 2, 3},
+			b:    []int{2},
+			diff: []int{1, 3},
+			ok:   true,
+		},
+		{
+			a:  []int{1, 2, 3},
+			b:  []int{4},
+			ok: false,
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			diff, ok := difference(test.a, test.b)
+
+			if ok != test.ok {
+				t.Fatalf("Unexpected result: %t.", ok)
+			}
+
+			if ok && !reflect.DeepEqual(diff, test.diff) {
+				t.Errorf("Unexpected difference is returned: %v.", diff)
+			}
+		})
+	}
+}
+
+func Test_groupConstraints(t *testing.T) {
+	constraints := []*constraint{
+		{cells: []int{1, 2}, mines: 1},
+		{cells: []int{5, 6}, mines: 1},
+		{cells: []int{2, 3}, mines: 1},
+	}
+
+	groups := groupConstraints(constraints)
+
+	if len(groups) != 2 {
+		t.Fatalf("Unexpected number of groups: %d.", len(groups))
+	}
+
+	if len(groups[0]) != 2 || groups[0][0] != constraints[0] || groups[0][1] != constraints[2] {
+		t.Errorf("Unexpected group is returned: %v.", groups[0])
+	}
+}
+
+func Test_enumerate(t *testing.T) {
+	// 1-2-1 pattern
+	constraints := []*constraint{
+		{cells: []int{0, 1}, mines: 1},
+		{cells: []int{0, 1, 2}, mines: 2},
+		{cells: []int{1, 2}, mines: 1},
+	}
+
+	result := enumerate(constraints)
+
+	if result == nil {
+		t.Fatal("Result is not returned.")
+	}
+
+	if result.total != 1 {
+		t.Errorf("Unexpected number of placements: %d.", result.total)
+	}
+
+	expected := map[int]int{0: 1, 1: 0, 2: 1}
+	if !reflect.DeepEqual(result.mineCnts, expected) {
+		t.Errorf("Unexpected counts are returned: %v.", result.mineCnts)
+	}
+
+	inconsistent := []*constraint{
+		{cells: []int{0}, mines: 1},
+		{cells: []int{0}, mines: 0},
+	}
+	if enumerate(inconsistent) != nil {
+		t.Error("Result should not be returned for inconsistent constraints.")
+	}
+}
+
+func TestMineProbabilities(t *testing.T) {
+	field := buildField("*...")
+	field.Cells[0][3].(*cell).state = Opened
+
+	probabilities := MineProbabilities(field)
+
+	if !reflect.DeepEqual(probabilities, [][]float64{{0.5, 0.5, 0, 0}}) {
+		t.Errorf("Unexpected probabilities are returned: %v.", probabilities)
+	}
+}
+
+func Test_mineProbabilities(t *testing.T) {
+	tests := []struct {
+		field    *Field
+		opened   []*Coordinate
+		exploded []*Coordinate
+		mineCnt  int
+		expected [][]float64
+	}{
+		{
+			field:    buildField("*..."),
+			opened:   []*Coordinate{{X: 1, Y: 0}},
+			mineCnt:  1,
+			expected: [][]float64{{0.5, 0, 0.5, 0}},
+		},
+		{
+			field: buildField(
+				"*..",
+				"...",
+				"..*",
+			),
+			opened:  []*Coordinate{{X: 1, Y: 1}},
+			mineCnt: 2,
+			expected: [][]float64{
+				{0.25, 0.25, 0.25},
+				{0.25, 0, 0.25},
+				{0.25, 0.25, 0.25},
+			},
+		},
+		{
+			field:    buildField("*..."),
+			exploded: []*Coordinate{{X: 0, Y: 0}},
+			mineCnt:  1,
+			expected: [][]float64{{1, 0, 0, 0}},
+		},
+		{
+			field:    buildField("*..."),
+			mineCnt:  1,
+			expected: [][]float64{{0.25, 0.25, 0.25, 0.25}},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			for _, c := range test.opened {
+				test.field.Cells[c.Y][c.X].(*cell).state = Opened
+			}
+			for _, c := range test.exploded {
+				test.field.Cells[c.Y][c.X].(*cell).state = Exploded
+			}
+
+			probabilities := mineProbabilities(test.field, test.mineCnt)
+
+			if !reflect.DeepEqual(probabilities, test.expected) {
+				t.Errorf("Unexpected probabilities are returned: %v.", probabilities)
+			}
+		})
+	}
+}
+
+func coordsString(coords []*Coordinate) string {
+	str := ""
+	for _, c := range coords {
+		str += fmt.Sprintf("%d:%d ", c.X, c.Y)
+	}
+	return str
+}
@@ -4,7 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"math"
+	"os"
 	"strconv"
 	"strings"
 )
@@ -14,6 +14,24 @@ var (
 	ErrInvalidInput = errors.New("invalid input is given")
 )
 
+// HighContrastEnv is the name of an environment variable that enables high-contrast large-cell rendering
+// when UI is not given via GameOption.
+// "2x1" selects Block2x1 and any other non-empty value selects Block3x2.
+const HighContrastEnv = "MINESWEEPER_HIGH_CONTRAST"
+
+// BlockSize depicts a size of a block that each cell is drawn as by high-contrast rendering.
+type BlockSize int
+
+const (
+	_ BlockSize = iota
+
+	// Block2x1 represents a block that is 2 characters wide and 1 line high.
+	Block2x1
+
+	// Block3x2 represents a block that is 3 characters wide and 2 lines high.
+	Block3x2
+)
+
 // UI defines an interface to output user friendly representation of a game and receive user input for operation.
 type UI interface {
 	// Render outputs user friendly representation of a game via given io.Writer.
@@ -24,11 +42,68 @@ type UI interface {
 }
 
 type defaultUI struct {
-	// [1, 2, 3, 4, ...]
-	xSymbols []int
+	// [1, 2, 3, 4, ...] by default
+	xSymbols []string
 
-	// [a, b, c, ...., aa, ab, ...]
+	// [a, b, c, ...., aa, ab, ...] by default
 	ySymbols []string
+
+	// labeling decides xSymbols and ySymbols. NewLabeling is used when this is nil.
+	labeling *Labeling
+
+	// dispCell converts a cell to its displayed form.
+	// Representation by dispState is used when this is nil.
+	dispCell func(Cell) string
+
+	// cellWidth is the number of columns each displayed cell occupies on terminal.
+	// Zero is treated as 1.
+	cellWidth int
+
+	// cellHeight is the number of lines each displayed cell occupies on terminal.
+	// Zero is treated as 1.
+	cellHeight int
+
+	// mineCntHints tells whether to show the total number of mines at the end of each row and below each column.
+	mineCntHints bool
+
+	// revealMines tells whether to draw closed cells with mines faintly instead of hiding them.
+	revealMines bool
+}
+
+func newDefaultUI() UI {
+	switch os.Getenv(HighContrastEnv) {
+	case "":
+		return &defaultUI{}
+
+	case "2x1":
+		return newHighContrastUI(Block2x1)
+
+	default:
+		return newHighContrastUI(Block3x2)
+
+	}
+}
+
+func newHighContrastUI(size BlockSize) *defaultUI {
+	switch size {
+	case Block2x1:
+		return &defaultUI{
+			dispCell:   dispHighContrast(2),
+			cellWidth:  2,
+			cellHeight: 1,
+		}
+
+	case Block3x2:
+		return &defaultUI{
+			dispCell:   dispHighContrast(3),
+			cellWidth:  3,
+			cellHeight: 2,
+		}
+
+	default:
+		panic(fmt.Sprintf("unknown block size is given: %d", size))
+
+	}
 }
 
 func (r *defaultUI) Render(w io.Writer, field *Field) (int, error) {
@@ -43,18 +118,65 @@ func (r *defaultUI) Render(w io.Writer, field *Field) (int, error) {
 		str += " "
 	}
 
+	cellWidth := r.cellWidth
+	if cellWidth == 0 {
+		cellWidth = 1
+	}
 	for _, symbol := range r.xSymbols {
-		str += fmt.Sprintf(" %d", symbol)
+		str += fmt.Sprintf(" %*s", cellWidth, symbol)
 	}
 	str += "\n"
 
+	dispCell := r.dispCell
+	if dispCell == nil {
+		dispCell = func(c Cell) string {
+			return dispState(c.State())
+		}
+	}
+
+	cellHeight := r.cellHeight
+	if cellHeight == 0 {
+		cellHeight = 1
+	}
+
+	rowCnts := field.RowMineCnts()
 	for i, row := range field.Cells {
-		str += r.ySymbols[i]
-		for _, cell := range row {
-			str += fmt.Sprintf("|%s", dispState(cell.State()))
+		for line := 0; line < cellHeight; line++ {
+			if line == 0 {
+				str += r.ySymbols[i]
+			} else {
+				str += strings.Repeat(" ", len(r.ySymbols[i]))
+			}
+
+			for x, cell := range row {
+				if r.revealMines && cell.State() == Closed && cell.hasMine() {
+					str += "|" + strings.Repeat("░", cellWidth)
+					continue
+				}
+				if start := field.SafeStart; start != nil && start.X == x && start.Y == i && cell.State() == Closed {
+					str += "|" + strings.Repeat("S", cellWidth)
+					continue
+				}
+				str += fmt.Sprintf("|%s", dispCell(cell))
+			}
+
+			if r.mineCntHints {
+				str += "|"
+				if line == 0 {
+					str += fmt.Sprintf(" %d", rowCnts[i])
+				}
+			}
+
+			if i+1 < field.Height || line+1 < cellHeight {
+				str += "\n"
+			}
 		}
-		if i+1 < field.Height {
-			str += "\n"
+	}
+
+	if r.mineCntHints {
+		str += "\n" + strings.Repeat(" ", yWidth)
+		for _, cnt := range field.ColumnMineCnts() {
+			str += fmt.Sprintf(" %*d", cellWidth, cnt)
 		}
 	}
 
@@ -68,31 +190,12 @@ func (r *defaultUI) ParseInput(b []byte) (OpType, *Coordinate, error) {
 		return 0, nil, ErrInvalidInput
 	}
 
-	x, err := strconv.Atoi(fields[0])
-	if err != nil {
-		return 0, nil, ErrInvalidInput
-	}
-
-	var foundX bool
-	xCoord := 0
-	for i, v := range r.xSymbols {
-		if x == v {
-			foundX = true
-			xCoord = i
-		}
-	}
+	xCoord, foundX := symbolIndex(r.xSymbols, fields[0])
 	if !(foundX) {
 		return 0, nil, ErrInvalidInput
 	}
 
-	var foundY bool
-	yCoord := 0
-	for i, v := range r.ySymbols {
-		if fields[1] == v {
-			foundY = true
-			yCoord = i
-		}
-	}
+	yCoord, foundY := symbolIndex(r.ySymbols, fields[1])
 	if !(foundY) {
 		return 0, nil, ErrInvalidInput
 	}
@@ -116,24 +219,36 @@ func (r *defaultUI) ParseInput(b []byte) (OpType, *Coordinate, error) {
 	}
 }
 
-func (r *defaultUI) initSymbols(width int, height int) {
-	r.xSymbols = make([]int, width)
-	for i := 0; i < width; i++ {
-		r.xSymbols[i] = i + 1
+// symbolIndex returns the index of given input among symbols.
+// A numeric input is compared by its value when no symbol matches as is, so a number with leading zeros such as "01" is accepted.
+func symbolIndex(symbols []string, input string) (int, bool) {
+	for i, v := range symbols {
+		if input == v {
+			return i, true
+		}
 	}
 
-	r.ySymbols = make([]string, height)
-	candidates := [...]string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l", "m", "n", "o", "p", "q", "r", "s", "t", "u", "v", "w", "x", "y", "z"}
-	candidatesN := len(candidates)
-
-	for i := 0; i < height; i++ {
-		n := i + 1
-		for n > 0 {
-			n -= 1
-			r.ySymbols[i] = candidates[n%candidatesN] + r.ySymbols[i]
-			n = int(math.Floor(float64(n) / float64(candidatesN)))
+	n, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, false
+	}
+	for i, v := range symbols {
+		m, err := strconv.Atoi(v)
+		if err == nil && m == n {
+			return i, true
 		}
 	}
+	return 0, false
+}
+
+func (r *defaultUI) initSymbols(width int, height int) {
+	labeling := r.labeling
+	if labeling == nil {
+		labeling = NewLabeling()
+	}
+
+	r.xSymbols = labeling.xLabels(width)
+	r.ySymbols = labeling.yLabels(height)
 }
 
 func dispState(s CellState) string {
@@ -155,3 +270,55 @@ func dispState(s CellState) string {
 
 	}
 }
+
+func dispEmoji(c Cell) string {
+	switch c.State() {
+	case Closed:
+		return "🟩"
+
+	case Opened:
+		cnt := c.SurroundingCnt()
+		if cnt == 0 {
+			return "⬜"
+		}
+		// Keycap digit such as 1️⃣
+		return fmt.Sprintf("%d\uFE0F\u20E3", cnt)
+
+	case Flagged:
+		return "🚩"
+
+	case Exploded:
+		return "💥"
+
+	default:
+		panic("invalid state")
+
+	}
+}
+
+func dispHighContrast(width int) func(Cell) string {
+	return func(c Cell) string {
+		switch c.State() {
+		case Closed:
+			return strings.Repeat("█", width)
+
+		case Opened:
+			cnt := c.SurroundingCnt()
+			if cnt == 0 {
+				return strings.Repeat(" ", width)
+			}
+			left := (width - 1) / 2
+			return strings.Repeat(" ", left) + strconv.Itoa(cnt) + strings.Repeat(" ", width-left-1)
+
+		case Flagged:
+			return strings.Repeat("F", width)
+
+		case Exploded:
+			return strings.Repeat("X", width)
+
+		default:
+			panic("invalid state")
+
+		}
+	}
+}
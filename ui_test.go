@@ -3,10 +3,55 @@ package minesweeper
 import (
 	"bytes"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 )
 
+func Test_newDefaultUI(t *testing.T) {
+	tests := []struct {
+		env        string
+		cellWidth  int
+		cellHeight int
+	}{
+		{
+			env: "",
+		},
+		{
+			env:        "2x1",
+			cellWidth:  2,
+			cellHeight: 1,
+		},
+		{
+			env:        "1",
+			cellWidth:  3,
+			cellHeight: 2,
+		},
+	}
+
+	original := os.Getenv(HighContrastEnv)
+	defer os.Setenv(HighContrastEnv, original)
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			os.Setenv(HighContrastEnv, test.env)
+
+			ui, ok := newDefaultUI().(*defaultUI)
+			if !ok {
+				t.Fatalf("Unexpected UI is returned: %#v.", ui)
+			}
+
+			if ui.cellWidth != test.cellWidth {
+				t.Errorf("Unexpected cell width is set: %d.", ui.cellWidth)
+			}
+
+			if ui.cellHeight != test.cellHeight {
+				t.Errorf("Unexpected cell height is set: %d.", ui.cellHeight)
+			}
+		})
+	}
+}
+
 func TestDefaultUI_initSymbols(t *testing.T) {
 	width := 12
 	height := 800
@@ -23,13 +68,13 @@ func TestDefaultUI_initSymbols(t *testing.T) {
 	}
 
 	firstX := renderer.xSymbols[0]
-	if firstX != 1 {
-		t.Errorf("Unexpected symbol is returned: %d", firstX)
+	if firstX != "1" {
+		t.Errorf("Unexpected symbol is returned: %s", firstX)
 	}
 
 	lastX := renderer.xSymbols[width-1]
-	if lastX != width {
-		t.Errorf("Unexpected symbol is returned: %d", lastX)
+	if lastX != "12" {
+		t.Errorf("Unexpected symbol is returned: %s", lastX)
 	}
 
 	firstY := renderer.ySymbols[0]
@@ -88,6 +133,191 @@ func Test_dispState(t *testing.T) {
 	}
 }
 
+func Test_dispEmoji(t *testing.T) {
+	tests := []struct {
+		cell     Cell
+		expected string
+	}{
+		{
+			cell:     &cell{state: Closed},
+			expected: "🟩",
+		},
+		{
+			cell:     &cell{state: Opened, surroundingCnt: 0},
+			expected: "⬜",
+		},
+		{
+			cell:     &cell{state: Opened, surroundingCnt: 3},
+			expected: "3\uFE0F\u20E3",
+		},
+		{
+			cell:     &cell{state: Flagged},
+			expected: "🚩",
+		},
+		{
+			cell:     &cell{state: Exploded},
+			expected: "💥",
+		},
+		{
+			cell: &cell{state: 999},
+		},
+	}
+
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if test.expected != "" {
+						t.Fatal("Panicked unexpectedly.")
+					}
+				}
+			}()
+
+			result := dispEmoji(test.cell)
+
+			if result != test.expected {
+				t.Errorf(`Expected "%s" but "%s" was returned.`, test.expected, result)
+			}
+		})
+	}
+}
+
+func Test_dispHighContrast(t *testing.T) {
+	tests := []struct {
+		cell     Cell
+		expected string
+	}{
+		{
+			cell:     &cell{state: Closed},
+			expected: "███",
+		},
+		{
+			cell:     &cell{state: Opened, surroundingCnt: 0},
+			expected: "   ",
+		},
+		{
+			cell:     &cell{state: Opened, surroundingCnt: 3},
+			expected: " 3 ",
+		},
+		{
+			cell:     &cell{state: Flagged},
+			expected: "FFF",
+		},
+		{
+			cell:     &cell{state: Exploded},
+			expected: "XXX",
+		},
+		{
+			cell: &cell{state: 999},
+		},
+	}
+
+	disp := dispHighContrast(3)
+	for i, test := range tests {
+		t.Run(fmt.Sprintf("test #%d", i+1), func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != nil {
+					if test.expected != "" {
+						t.Fatal("Panicked unexpectedly.")
+					}
+				}
+			}()
+
+			result := disp(test.cell)
+
+			if result != test.expected {
+				t.Errorf(`Expected "%s" but "%s" was returned.`, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestDefaultUI_Render_HighContrast(t *testing.T) {
+	field := &Field{
+		Width:  2,
+		Height: 2,
+		Cells: [][]Cell{
+			{
+				&cell{state: Closed},
+				&cell{state: Opened, surroundingCnt: 1},
+			},
+			{
+				&cell{state: Flagged},
+				&cell{state: Exploded},
+			},
+		},
+	}
+
+	w := bytes.NewBuffer([]byte{})
+	r := newHighContrastUI(Block3x2)
+	_, err := r.Render(w, field)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	expected := "    1   2\na|███| 1 \n |███| 1 \nb|FFF|XXX\n |FFF|XXX"
+	if w.String() != expected {
+		t.Errorf("Unexpected output: \n%s", w.String())
+	}
+}
+
+func TestDefaultUI_Render_MineCntHints(t *testing.T) {
+	field := buildField(
+		"*.",
+		"**",
+	)
+
+	w := bytes.NewBuffer([]byte{})
+	r := &defaultUI{mineCntHints: true}
+	_, err := r.Render(w, field)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	expected := "  1 2\na| | | 1\nb| | | 2\n  2 1"
+	if w.String() != expected {
+		t.Errorf("Unexpected output: \n%s", w.String())
+	}
+}
+
+func TestDefaultUI_Render_RevealMines(t *testing.T) {
+	field := buildField("*.*")
+	field.Cells[0][2].(*cell).state = Flagged
+
+	w := bytes.NewBuffer([]byte{})
+	r := &defaultUI{revealMines: true}
+	_, err := r.Render(w, field)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	expected := "  1 2 3\na|░| |F"
+	if w.String() != expected {
+		t.Errorf("Unexpected output: \n%s", w.String())
+	}
+}
+
+func TestDefaultUI_Render_SafeStart(t *testing.T) {
+	field := buildField("*..")
+	field.SafeStart = &Coordinate{X: 2, Y: 0}
+
+	w := bytes.NewBuffer([]byte{})
+	r := &defaultUI{}
+	_, err := r.Render(w, field)
+
+	if err != nil {
+		t.Fatalf("Unexpected error is returned: %s.", err.Error())
+	}
+
+	expected := "  1 2 3\na| | |S"
+	if w.String() != expected {
+		t.Errorf("Unexpected output: \n%s", w.String())
+	}
+}
+
 func TestDefaultUI_Render(t *testing.T) {
 	field := &Field{
 		Width:  2,
@@ -127,47 +357,62 @@ func TestDefaultUI_Render(t *testing.T) {
 
 func TestDefaultUI_ParseInput(t *testing.T) {
 	tests := []struct {
-		xSymbols []int
+		xSymbols []string
 		ySymbols []string
 		input    []byte
 		opType   OpType
 		expected *Coordinate
 	}{
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b", "c"},
 			input:    []byte("2 c"),
 			opType:   Open,
 			expected: &Coordinate{X: 1, Y: 2},
 		},
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b", "c"},
 			input:    []byte("2 b f"),
 			opType:   Flag,
 			expected: &Coordinate{X: 1, Y: 1},
 		},
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b", "c"},
 			input:    []byte("2 b flag"),
 			opType:   Flag,
 			expected: &Coordinate{X: 1, Y: 1},
 		},
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b", "c"},
 			input:    []byte("2 a u"),
 			opType:   Unflag,
 			expected: &Coordinate{X: 1, Y: 0},
 		},
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b", "c"},
 			input:    []byte("2 a unflag"),
 			opType:   Unflag,
 			expected: &Coordinate{X: 1, Y: 0},
 		},
+		{
+			// Leading zeros
+			xSymbols: []string{"1", "2"},
+			ySymbols: []string{"a", "b", "c"},
+			input:    []byte("02 c"),
+			opType:   Open,
+			expected: &Coordinate{X: 1, Y: 2},
+		},
+		{
+			xSymbols: []string{"a", "b"},
+			ySymbols: []string{"0", "1", "2"},
+			input:    []byte("b 01"),
+			opType:   Open,
+			expected: &Coordinate{X: 1, Y: 1},
+		},
 		{
 			input: []byte("2 invalid"),
 		},
@@ -178,17 +423,17 @@ func TestDefaultUI_ParseInput(t *testing.T) {
 			input: []byte("invalid number of fields"),
 		},
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b"},
 			input:    []byte("100 a"),
 		},
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b"},
 			input:    []byte("1 zzz"),
 		},
 		{
-			xSymbols: []int{1, 2},
+			xSymbols: []string{"1", "2"},
 			ySymbols: []string{"a", "b", "c"},
 			input:    []byte("2 a invalid"),
 		},